@@ -0,0 +1,115 @@
+package gitea
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// apiError is returned by apiRequest when Gitea answers with a non 2xx status
+type apiError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// isNotFoundErr reports whether err is a 404 answer, either from apiRequest or
+// from the SDK which only surfaces it as a plain error message.
+func isNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if e, ok := err.(*apiError); ok {
+		return e.StatusCode == http.StatusNotFound
+	}
+	return strings.HasPrefix(err.Error(), "404")
+}
+
+// isForbiddenErr reports whether err is a 403 answer, either from apiRequest
+// or from the SDK.
+func isForbiddenErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if e, ok := err.(*apiError); ok {
+		return e.StatusCode == http.StatusForbidden
+	}
+	return strings.HasPrefix(err.Error(), "403")
+}
+
+// repoScope returns the API path prefix of a repository
+func repoScope(owner, repository string) string {
+	return fmt.Sprintf("/repos/%s/%s", owner, repository)
+}
+
+// orgScope returns the API path prefix of an organization
+func orgScope(org string) string {
+	return fmt.Sprintf("/orgs/%s", org)
+}
+
+// apiRequest calls an endpoint of the Gitea API v1 that the SDK does not
+// provide. body is encoded as JSON when not nil and the answer is decoded into
+// out when out is not nil.
+func (c *Client) apiRequest(method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+"/api/v1"+path, reader)
+	if err != nil {
+		return err
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	log.Printf("[DEBUG] gitea api request: %s %s", method, path)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		message := strings.TrimSpace(string(data))
+		errMap := map[string]interface{}{}
+		if json.Unmarshal(data, &errMap) == nil {
+			if m, ok := errMap["message"].(string); ok {
+				message = m
+			}
+		}
+		if message == "" {
+			message = http.StatusText(resp.StatusCode)
+		}
+		return &apiError{Method: method, Path: path, StatusCode: resp.StatusCode, Message: message}
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,217 @@
+package gitea
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// actionsSecret is a secret of Gitea Actions, Gitea never returns its value
+type actionsSecret struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created_at"`
+}
+
+// actionsVariable is a configuration variable of Gitea Actions
+type actionsVariable struct {
+	OwnerID int64  `json:"owner_id"`
+	RepoID  int64  `json:"repo_id"`
+	Name    string `json:"name"`
+	Data    string `json:"data"`
+}
+
+// findActionsSecret pages through the secrets of a scope looking for name,
+// returning nil when it does not exist.
+func (c *Client) findActionsSecret(scope, name string) (*actionsSecret, error) {
+	const limit = 50
+	for page := 1; ; page++ {
+		var secrets []*actionsSecret
+		path := fmt.Sprintf("%s/actions/secrets?page=%d&limit=%d", scope, page, limit)
+		if err := c.apiRequest("GET", path, nil, &secrets); err != nil {
+			return nil, err
+		}
+		for _, secret := range secrets {
+			if strings.EqualFold(secret.Name, name) {
+				return secret, nil
+			}
+		}
+		if len(secrets) < limit {
+			return nil, nil
+		}
+	}
+}
+
+func (c *Client) putActionsSecret(scope, name, data string) error {
+	body := map[string]string{"data": data}
+	return c.apiRequest("PUT", fmt.Sprintf("%s/actions/secrets/%s", scope, name), body, nil)
+}
+
+func (c *Client) deleteActionsSecret(scope, name string) error {
+	return c.apiRequest("DELETE", fmt.Sprintf("%s/actions/secrets/%s", scope, name), nil, nil)
+}
+
+func (c *Client) getActionsVariable(scope, name string) (*actionsVariable, error) {
+	variable := new(actionsVariable)
+	return variable, c.apiRequest("GET", fmt.Sprintf("%s/actions/variables/%s", scope, name), nil, variable)
+}
+
+func (c *Client) createActionsVariable(scope, name, value string) error {
+	body := map[string]string{"value": value}
+	return c.apiRequest("POST", fmt.Sprintf("%s/actions/variables/%s", scope, name), body, nil)
+}
+
+func (c *Client) updateActionsVariable(scope, name, value string) error {
+	body := map[string]string{"name": name, "value": value}
+	return c.apiRequest("PUT", fmt.Sprintf("%s/actions/variables/%s", scope, name), body, nil)
+}
+
+func (c *Client) deleteActionsVariable(scope, name string) error {
+	return c.apiRequest("DELETE", fmt.Sprintf("%s/actions/variables/%s", scope, name), nil, nil)
+}
+
+var actionsNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validateActionsName checks a secret or variable name the way Gitea does:
+// alphanumerics and underscores only, not starting with a digit nor with the
+// reserved GITEA_ and GITHUB_ prefixes.
+func validateActionsName(v interface{}, k string) (ws []string, errors []error) {
+	name := v.(string)
+	if !actionsNameRegexp.MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q must only contain alphanumeric characters or underscores and not start with a number, got %q", k, name))
+	}
+	upper := strings.ToUpper(name)
+	if strings.HasPrefix(upper, "GITEA_") || strings.HasPrefix(upper, "GITHUB_") {
+		errors = append(errors, fmt.Errorf("%q must not start with GITEA_ or GITHUB_, got %q", k, name))
+	}
+	return
+}
+
+// actionsNameSchema is the name of a secret or variable. Gitea stores names
+// upper case, so they are kept that way in state.
+func actionsNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateActionsName,
+		StateFunc: func(v interface{}) string {
+			return strings.ToUpper(v.(string))
+		},
+	}
+}
+
+// actionsRunner is a self-hosted Gitea Actions runner
+type actionsRunner struct {
+	ID        int64                 `json:"id"`
+	Name      string                `json:"name"`
+	Status    string                `json:"status"`
+	Busy      bool                  `json:"busy"`
+	Ephemeral bool                  `json:"ephemeral"`
+	Labels    []*actionsRunnerLabel `json:"labels"`
+}
+
+type actionsRunnerLabel struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type actionsRunnerList struct {
+	Runners    []*actionsRunner `json:"runners"`
+	TotalCount int64            `json:"total_count"`
+}
+
+var actionsRunnerScopes = []string{"instance", "organization", "repository"}
+
+// actionsRunnerScopeSchema adds the attributes selecting which runners, those
+// of the whole instance, of an organization or of a repository, are targeted.
+func actionsRunnerScopeSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["scope"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "instance",
+		ValidateFunc: validation.StringInSlice(actionsRunnerScopes, false),
+	}
+	s["organization"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["owner"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["repository"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return s
+}
+
+// actionsRunnerScopePaths returns the API paths of the registration token and
+// of the runners for the scope configured in d. Attributes of another scope
+// are rejected rather than ignored.
+func actionsRunnerScopePaths(d *schema.ResourceData) (tokenPath string, runnersPath string, err error) {
+	org := d.Get("organization").(string)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	switch scope := d.Get("scope").(string); scope {
+	case "organization":
+		if org == "" {
+			return "", "", fmt.Errorf("organization must be set when scope is organization")
+		}
+		if owner != "" || repository != "" {
+			return "", "", fmt.Errorf("owner and repository can only be set when scope is repository")
+		}
+		scope := orgScope(org)
+		return scope + "/actions/runners/registration-token", scope + "/actions/runners", nil
+	case "repository":
+		if owner == "" || repository == "" {
+			return "", "", fmt.Errorf("owner and repository must be set when scope is repository")
+		}
+		if org != "" {
+			return "", "", fmt.Errorf("organization can only be set when scope is organization")
+		}
+		scope := repoScope(owner, repository)
+		return scope + "/actions/runners/registration-token", scope + "/actions/runners", nil
+	default:
+		if org != "" || owner != "" || repository != "" {
+			return "", "", fmt.Errorf("organization, owner and repository can not be set when scope is %s", scope)
+		}
+		return "/admin/runners/registration-token", "/admin/actions/runners", nil
+	}
+}
+
+func (c *Client) getActionsRunnerToken(tokenPath string) (string, error) {
+	token := struct {
+		Token string `json:"token"`
+	}{}
+	if err := c.apiRequest("GET", tokenPath, nil, &token); err != nil {
+		return "", err
+	}
+	return token.Token, nil
+}
+
+func (c *Client) listActionsRunners(runnersPath string) ([]*actionsRunner, error) {
+	const limit = 50
+	runners := []*actionsRunner{}
+	for page := 1; ; page++ {
+		list := new(actionsRunnerList)
+		path := fmt.Sprintf("%s?page=%d&limit=%d", runnersPath, page, limit)
+		if err := c.apiRequest("GET", path, nil, list); err != nil {
+			return nil, err
+		}
+		runners = append(runners, list.Runners...)
+		if len(list.Runners) < limit || int64(len(runners)) >= list.TotalCount {
+			return runners, nil
+		}
+	}
+}
+
+// deleteActionsRunner deregisters the runner id of runnersPath
+func (c *Client) deleteActionsRunner(runnersPath string, id int64) error {
+	return c.apiRequest("DELETE", fmt.Sprintf("%s/%d", runnersPath, id), nil, nil)
+}
@@ -0,0 +1,121 @@
+package gitea
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestActionsRunnerScopePaths(t *testing.T) {
+	cases := []struct {
+		name        string
+		raw         map[string]interface{}
+		tokenPath   string
+		runnersPath string
+		err         bool
+	}{
+		{
+			name:        "instance",
+			raw:         map[string]interface{}{},
+			tokenPath:   "/admin/runners/registration-token",
+			runnersPath: "/admin/actions/runners",
+		},
+		{
+			name: "organization",
+			raw: map[string]interface{}{
+				"scope":        "organization",
+				"organization": "acme",
+			},
+			tokenPath:   "/orgs/acme/actions/runners/registration-token",
+			runnersPath: "/orgs/acme/actions/runners",
+		},
+		{
+			name: "repository",
+			raw: map[string]interface{}{
+				"scope":      "repository",
+				"owner":      "acme",
+				"repository": "app",
+			},
+			tokenPath:   "/repos/acme/app/actions/runners/registration-token",
+			runnersPath: "/repos/acme/app/actions/runners",
+		},
+		{
+			name: "instance with repository",
+			raw: map[string]interface{}{
+				"owner":      "acme",
+				"repository": "app",
+			},
+			err: true,
+		},
+		{
+			name: "organization without organization",
+			raw: map[string]interface{}{
+				"scope": "organization",
+			},
+			err: true,
+		},
+		{
+			name: "organization with owner",
+			raw: map[string]interface{}{
+				"scope":        "organization",
+				"organization": "acme",
+				"owner":        "acme",
+			},
+			err: true,
+		},
+		{
+			name: "repository with organization",
+			raw: map[string]interface{}{
+				"scope":        "repository",
+				"organization": "acme",
+				"owner":        "acme",
+				"repository":   "app",
+			},
+			err: true,
+		},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceGiteaActionsRunners().Schema, c.raw)
+		tokenPath, runnersPath, err := actionsRunnerScopePaths(d)
+		if c.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", c.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if tokenPath != c.tokenPath || runnersPath != c.runnersPath {
+			t.Errorf("%s: expected %q and %q, got %q and %q", c.name, c.tokenPath, c.runnersPath, tokenPath, runnersPath)
+		}
+	}
+}
+
+func TestFlattenGiteaActionsRunners(t *testing.T) {
+	runners := flattenGiteaActionsRunners([]*actionsRunner{
+		{
+			ID:     3,
+			Name:   "runner-1",
+			Status: "offline",
+			Labels: []*actionsRunnerLabel{{Name: "ubuntu-latest"}, {Name: "docker"}},
+		},
+		{ID: 4, Name: "runner-2", Status: "idle"},
+	})
+	if len(runners) != 2 {
+		t.Fatalf("expected 2 runners, got %d", len(runners))
+	}
+	first := runners[0].(map[string]interface{})
+	if first["name"] != "runner-1" || first["status"] != "offline" {
+		t.Errorf("unexpected runner %v", first)
+	}
+	labels := first["labels"].([]interface{})
+	if len(labels) != 2 || labels[0] != "ubuntu-latest" || labels[1] != "docker" {
+		t.Errorf("unexpected labels %v", labels)
+	}
+	if labels := runners[1].(map[string]interface{})["labels"].([]interface{}); len(labels) != 0 {
+		t.Errorf("expected no labels, got %v", labels)
+	}
+}
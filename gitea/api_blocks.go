@@ -0,0 +1,57 @@
+package gitea
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+)
+
+// userBlockScope is the API path prefix of the blocks of the token owner
+const userBlockScope = "/user"
+
+// listBlocks pages through the users blocked by the organization or user of
+// scope
+func (c *Client) listBlocks(scope string) ([]*giteaapi.User, error) {
+	const limit = 50
+	users := []*giteaapi.User{}
+	for page := 1; ; page++ {
+		var list []*giteaapi.User
+		path := fmt.Sprintf("%s/blocks?page=%d&limit=%d", scope, page, limit)
+		if err := c.apiRequest("GET", path, nil, &list); err != nil {
+			return nil, err
+		}
+		users = append(users, list...)
+		if len(list) < limit {
+			return users, nil
+		}
+	}
+}
+
+// findBlock returns the user username blocked by the organization or user of
+// scope, nil when username is not blocked
+func (c *Client) findBlock(scope, username string) (*giteaapi.User, error) {
+	users, err := c.listBlocks(scope)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.UserName, username) {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Client) blockUser(scope, username, note string) error {
+	path := fmt.Sprintf("%s/blocks/%s", scope, username)
+	if note != "" {
+		path += "?note=" + url.QueryEscape(note)
+	}
+	return c.apiRequest("PUT", path, nil, nil)
+}
+
+func (c *Client) unblockUser(scope, username string) error {
+	return c.apiRequest("DELETE", fmt.Sprintf("%s/blocks/%s", scope, username), nil, nil)
+}
@@ -0,0 +1,41 @@
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestFindBlock(t *testing.T) {
+	client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/orgs/acme/blocks" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// a full first page of 50 users, then the blocked user
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		users := []map[string]interface{}{}
+		if page == 1 {
+			for i := 0; i < 50; i++ {
+				users = append(users, map[string]interface{}{"login": fmt.Sprintf("user%d", i)})
+			}
+		} else if page == 2 {
+			users = append(users, map[string]interface{}{"login": "Spammer"})
+		}
+		writeJSON(w, http.StatusOK, users)
+	})
+	defer done()
+
+	user, err := client.findBlock(orgScope("acme"), "spammer")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user == nil || user.UserName != "Spammer" {
+		t.Errorf("expected Spammer to be blocked, got %v", user)
+	}
+
+	user, err = client.findBlock(orgScope("acme"), "someone")
+	if err != nil || user != nil {
+		t.Errorf("expected someone not to be blocked, got %v, %v", user, err)
+	}
+}
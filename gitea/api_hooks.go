@@ -0,0 +1,80 @@
+package gitea
+
+import (
+	"fmt"
+	"net/url"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+)
+
+// createHookOption extends the SDK option with the fields newer Gitea
+// versions accept when creating a hook
+type createHookOption struct {
+	giteaapi.CreateHookOption
+	AuthorizationHeader string `json:"authorization_header,omitempty"`
+	// IsSystemWebhook only applies to admin hooks, false creates a default
+	// hook copied into new repositories instead of a system hook
+	IsSystemWebhook *bool `json:"is_system_webhook,omitempty"`
+}
+
+// editHookOption extends the SDK option with the fields newer Gitea
+// versions accept when editing a hook
+type editHookOption struct {
+	giteaapi.EditHookOption
+	AuthorizationHeader string `json:"authorization_header"`
+}
+
+// createHook creates a hook under scope, the API path prefix of a repository
+// or of an organization
+func (c *Client) createHook(scope string, opt createHookOption) (*giteaapi.Hook, error) {
+	hook := new(giteaapi.Hook)
+	return hook, c.apiRequest("POST", scope+"/hooks", opt, hook)
+}
+
+// editHook edits a hook under scope, the API path prefix of a repository or
+// of an organization
+func (c *Client) editHook(scope string, id int64, opt editHookOption) error {
+	return c.apiRequest("PATCH", fmt.Sprintf("%s/hooks/%d", scope, id), opt, nil)
+}
+
+func (c *Client) getHook(scope string, id int64) (*giteaapi.Hook, error) {
+	hook := new(giteaapi.Hook)
+	return hook, c.apiRequest("GET", fmt.Sprintf("%s/hooks/%d", scope, id), nil, hook)
+}
+
+func (c *Client) deleteHook(scope string, id int64) error {
+	return c.apiRequest("DELETE", fmt.Sprintf("%s/hooks/%d", scope, id), nil, nil)
+}
+
+// listHooks returns every hook under scope, query is added to each page
+// request to filter the hooks
+func (c *Client) listHooks(scope string, query url.Values) ([]*giteaapi.Hook, error) {
+	const limit = 50
+	hooks := []*giteaapi.Hook{}
+	if query == nil {
+		query = url.Values{}
+	}
+	for page := 1; ; page++ {
+		query.Set("page", fmt.Sprintf("%d", page))
+		query.Set("limit", fmt.Sprintf("%d", limit))
+		var list []*giteaapi.Hook
+		if err := c.apiRequest("GET", fmt.Sprintf("%s/hooks?%s", scope, query.Encode()), nil, &list); err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, list...)
+		if len(list) < limit {
+			return hooks, nil
+		}
+	}
+}
+
+// testHook asks Gitea to deliver a test push event through a repository hook.
+// Gitea queues the delivery and answers before it is sent, so errors are about
+// triggering the test only.
+//
+// TODO: fail on the status returned by the receiver and add the
+// gitea_repository_hook_deliveries data source once the Gitea API lists hook
+// deliveries, it only shows them in the web interface for now.
+func (c *Client) testHook(scope string, id int64) error {
+	return c.apiRequest("POST", fmt.Sprintf("%s/hooks/%d/tests", scope, id), nil, nil)
+}
@@ -0,0 +1,162 @@
+package gitea
+
+import (
+	"fmt"
+	"strings"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+)
+
+// orgOwnersTeam is the team Gitea creates with every organization, its
+// members are the owners of the organization
+const orgOwnersTeam = "Owners"
+
+// apiOrganization extends the SDK organization with the fields the SDK does
+// not know about yet
+type apiOrganization struct {
+	giteaapi.Organization
+	// Email is left out when empty, Gitea can not clear it anyway
+	Email                     string `json:"email,omitempty"`
+	RepoAdminChangeTeamAccess bool   `json:"repo_admin_change_team_access"`
+}
+
+// createOrgOption extends the SDK option with the fields the SDK does not
+// know about yet
+type createOrgOption struct {
+	giteaapi.CreateOrgOption
+	// Email is left out when empty, Gitea can not clear it anyway
+	Email                     string `json:"email,omitempty"`
+	RepoAdminChangeTeamAccess bool   `json:"repo_admin_change_team_access"`
+}
+
+// editOrgOption extends the SDK option with the fields the SDK does not know
+// about yet
+type editOrgOption struct {
+	giteaapi.EditOrgOption
+	// Email is left out when empty, Gitea can not clear it anyway
+	Email                     string `json:"email,omitempty"`
+	RepoAdminChangeTeamAccess *bool  `json:"repo_admin_change_team_access,omitempty"`
+}
+
+func (c *Client) getOrg(name string) (*apiOrganization, error) {
+	org := new(apiOrganization)
+	return org, c.apiRequest("GET", orgScope(name), nil, org)
+}
+
+func (c *Client) createOrg(opt createOrgOption) (*apiOrganization, error) {
+	org := new(apiOrganization)
+	return org, c.apiRequest("POST", "/orgs", opt, org)
+}
+
+func (c *Client) editOrg(name string, opt editOrgOption) (*apiOrganization, error) {
+	org := new(apiOrganization)
+	return org, c.apiRequest("PATCH", orgScope(name), opt, org)
+}
+
+// setOrgMaxRepoCreation limits the number of repositories of an organization,
+// -1 meaning the instance default. Only the admin API can change it, as
+// organizations are users to it. The admin API does not return it back.
+func (c *Client) setOrgMaxRepoCreation(name string, max int) error {
+	body := map[string]interface{}{
+		"login_name":        name,
+		"source_id":         0,
+		"max_repo_creation": max,
+	}
+	return c.apiRequest("PATCH", "/admin/users/"+name, body, nil)
+}
+
+// apiTeam extends the SDK team with the fields the SDK does not know about
+// yet
+type apiTeam struct {
+	giteaapi.Team
+	IncludesAllRepositories bool `json:"includes_all_repositories"`
+	CanCreateOrgRepo        bool `json:"can_create_org_repo"`
+}
+
+// listOrgTeams pages through all the teams of an organization
+func (c *Client) listOrgTeams(org string) ([]*apiTeam, error) {
+	const limit = 50
+	teams := []*apiTeam{}
+	for page := 1; ; page++ {
+		var list []*apiTeam
+		path := fmt.Sprintf("%s/teams?page=%d&limit=%d", orgScope(org), page, limit)
+		if err := c.apiRequest("GET", path, nil, &list); err != nil {
+			return nil, err
+		}
+		teams = append(teams, list...)
+		if len(list) < limit {
+			return teams, nil
+		}
+	}
+}
+
+// findOrgTeam looks a team of an organization up by name, returning nil when
+// it does not exist.
+func (c *Client) findOrgTeam(org, name string) (*apiTeam, error) {
+	teams, err := c.listOrgTeams(org)
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, name) {
+			return team, nil
+		}
+	}
+	return nil, nil
+}
+
+// listOrgMembers pages through all the members of an organization
+func (c *Client) listOrgMembers(org string) ([]*giteaapi.User, error) {
+	const limit = 50
+	members := []*giteaapi.User{}
+	for page := 1; ; page++ {
+		var list []*giteaapi.User
+		path := fmt.Sprintf("%s/members?page=%d&limit=%d", orgScope(org), page, limit)
+		if err := c.apiRequest("GET", path, nil, &list); err != nil {
+			return nil, err
+		}
+		members = append(members, list...)
+		if len(list) < limit {
+			return members, nil
+		}
+	}
+}
+
+// listTeamMembers pages through all the members of the team id
+func (c *Client) listTeamMembers(id int64) ([]*giteaapi.User, error) {
+	const limit = 50
+	members := []*giteaapi.User{}
+	for page := 1; ; page++ {
+		var list []*giteaapi.User
+		path := fmt.Sprintf("/teams/%d/members?page=%d&limit=%d", id, page, limit)
+		if err := c.apiRequest("GET", path, nil, &list); err != nil {
+			return nil, err
+		}
+		members = append(members, list...)
+		if len(list) < limit {
+			return members, nil
+		}
+	}
+}
+
+// isTeamMember reports whether user is a member of the team id
+func (c *Client) isTeamMember(id int64, user string) (bool, error) {
+	err := c.apiRequest("GET", fmt.Sprintf("/teams/%d/members/%s", id, user), nil, nil)
+	if isNotFoundErr(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// addOrgMember makes user a member of an organization through one of its
+// teams, Gitea having no membership outside of teams.
+func (c *Client) addOrgMember(org, teamName, user string) error {
+	team, err := c.findOrgTeam(org, teamName)
+	if err != nil {
+		return fmt.Errorf("unable to list teams of organization %s: %v", org, err)
+	}
+	if team == nil {
+		return fmt.Errorf("organization %s has no team %s", org, teamName)
+	}
+	return c.AddTeamMember(team.ID, user)
+}
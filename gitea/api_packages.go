@@ -0,0 +1,36 @@
+package gitea
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// giteaPackage is a version of a package of the Gitea package registry
+type giteaPackage struct {
+	ID      int64  `json:"id"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// listPackages pages through all the package versions owned by owner
+func (c *Client) listPackages(owner string) ([]*giteaPackage, error) {
+	const limit = 50
+	packages := []*giteaPackage{}
+	for page := 1; ; page++ {
+		var list []*giteaPackage
+		path := fmt.Sprintf("/packages/%s?page=%d&limit=%d", owner, page, limit)
+		if err := c.apiRequest("GET", path, nil, &list); err != nil {
+			return nil, err
+		}
+		packages = append(packages, list...)
+		if len(list) < limit {
+			return packages, nil
+		}
+	}
+}
+
+func (c *Client) deletePackage(owner string, pkg *giteaPackage) error {
+	path := fmt.Sprintf("/packages/%s/%s/%s/%s", owner, pkg.Type, url.PathEscape(pkg.Name), url.PathEscape(pkg.Version))
+	return c.apiRequest("DELETE", path, nil, nil)
+}
@@ -0,0 +1,212 @@
+package gitea
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+)
+
+// searchRepos pages through the repository search endpoint with the given
+// filters. Unlike the SDK it does not force the private and template filters.
+func (c *Client) searchRepos(query url.Values) ([]*giteaapi.Repository, error) {
+	const limit = 50
+	repos := []*giteaapi.Repository{}
+	for page := 1; ; page++ {
+		query.Set("page", fmt.Sprintf("%d", page))
+		query.Set("limit", fmt.Sprintf("%d", limit))
+		result := struct {
+			Data []*giteaapi.Repository `json:"data"`
+		}{}
+		if err := c.apiRequest("GET", "/repos/search?"+query.Encode(), nil, &result); err != nil {
+			return nil, err
+		}
+		repos = append(repos, result.Data...)
+		if len(result.Data) < limit {
+			return repos, nil
+		}
+	}
+}
+
+// listUserRepos pages through all the repositories of a user
+func (c *Client) listUserRepos(user string) ([]*giteaapi.Repository, error) {
+	const limit = 50
+	repos := []*giteaapi.Repository{}
+	for page := 1; ; page++ {
+		var list []*giteaapi.Repository
+		path := fmt.Sprintf("/users/%s/repos?page=%d&limit=%d", user, page, limit)
+		if err := c.apiRequest("GET", path, nil, &list); err != nil {
+			return nil, err
+		}
+		repos = append(repos, list...)
+		if len(list) < limit {
+			return repos, nil
+		}
+	}
+}
+
+// listOrgRepos pages through all the repositories of an organization
+func (c *Client) listOrgRepos(org string) ([]*giteaapi.Repository, error) {
+	const limit = 50
+	repos := []*giteaapi.Repository{}
+	for page := 1; ; page++ {
+		var list []*giteaapi.Repository
+		path := fmt.Sprintf("%s/repos?page=%d&limit=%d", orgScope(org), page, limit)
+		if err := c.apiRequest("GET", path, nil, &list); err != nil {
+			return nil, err
+		}
+		repos = append(repos, list...)
+		if len(list) < limit {
+			return repos, nil
+		}
+	}
+}
+
+// apiRepository extends the SDK repository with the fields the SDK does not
+// know about yet
+type apiRepository struct {
+	giteaapi.Repository
+	Template                      bool                       `json:"template"`
+	HasProjects                   bool                       `json:"has_projects"`
+	HasReleases                   bool                       `json:"has_releases"`
+	HasPackages                   bool                       `json:"has_packages"`
+	HasActions                    bool                       `json:"has_actions"`
+	InternalTracker               *repositoryInternalTracker `json:"internal_tracker"`
+	ExternalTracker               *repositoryExternalTracker `json:"external_tracker"`
+	ExternalWiki                  *repositoryExternalWiki    `json:"external_wiki"`
+	DefaultMergeStyle             string                     `json:"default_merge_style"`
+	DefaultDeleteBranchAfterMerge bool                       `json:"default_delete_branch_after_merge"`
+	AllowRebaseUpdate             bool                       `json:"allow_rebase_update"`
+	MirrorInterval                string                     `json:"mirror_interval"`
+}
+
+// repositoryInternalTracker are the settings of the built-in issue tracker
+type repositoryInternalTracker struct {
+	EnableTimeTracker                bool `json:"enable_time_tracker"`
+	AllowOnlyContributorsToTrackTime bool `json:"allow_only_contributors_to_track_time"`
+	EnableIssueDependencies          bool `json:"enable_issue_dependencies"`
+}
+
+// repositoryExternalTracker replaces the built-in issue tracker by an
+// external one
+type repositoryExternalTracker struct {
+	ExternalTrackerURL           string `json:"external_tracker_url"`
+	ExternalTrackerFormat        string `json:"external_tracker_format"`
+	ExternalTrackerStyle         string `json:"external_tracker_style"`
+	ExternalTrackerRegexpPattern string `json:"external_tracker_regexp_pattern,omitempty"`
+}
+
+// repositoryExternalWiki replaces the built-in wiki by an external one
+type repositoryExternalWiki struct {
+	ExternalWikiURL string `json:"external_wiki_url"`
+}
+
+// editRepoOption extends the SDK option with the fields the SDK does not
+// know about yet
+type editRepoOption struct {
+	giteaapi.EditRepoOption
+	Template                      *bool                      `json:"template,omitempty"`
+	HasProjects                   *bool                      `json:"has_projects,omitempty"`
+	HasReleases                   *bool                      `json:"has_releases,omitempty"`
+	HasPackages                   *bool                      `json:"has_packages,omitempty"`
+	HasActions                    *bool                      `json:"has_actions,omitempty"`
+	InternalTracker               *repositoryInternalTracker `json:"internal_tracker,omitempty"`
+	ExternalTracker               *repositoryExternalTracker `json:"external_tracker,omitempty"`
+	ExternalWiki                  *repositoryExternalWiki    `json:"external_wiki,omitempty"`
+	DefaultMergeStyle             *string                    `json:"default_merge_style,omitempty"`
+	DefaultDeleteBranchAfterMerge *bool                      `json:"default_delete_branch_after_merge,omitempty"`
+	AllowManualMerge              *bool                      `json:"allow_manual_merge,omitempty"`
+	AutodetectManualMerge         *bool                      `json:"autodetect_manual_merge,omitempty"`
+	AllowRebaseUpdate             *bool                      `json:"allow_rebase_update,omitempty"`
+	MirrorInterval                *string                    `json:"mirror_interval,omitempty"`
+}
+
+// generateRepoOption are the options to create a repository from a template
+type generateRepoOption struct {
+	Owner       string `json:"owner"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Private     bool   `json:"private"`
+	GitContent  bool   `json:"git_content"`
+	Topics      bool   `json:"topics"`
+	GitHooks    bool   `json:"git_hooks"`
+	Webhooks    bool   `json:"webhooks"`
+	Avatar      bool   `json:"avatar"`
+	Labels      bool   `json:"labels"`
+}
+
+func (c *Client) getRepo(owner, name string) (*apiRepository, error) {
+	repo := new(apiRepository)
+	return repo, c.apiRequest("GET", repoScope(owner, name), nil, repo)
+}
+
+// getRepoByID looks a repository up by its numeric ID, which unlike its owner
+// and name never changes
+func (c *Client) getRepoByID(id int64) (*apiRepository, error) {
+	repo := new(apiRepository)
+	return repo, c.apiRequest("GET", fmt.Sprintf("/repositories/%d", id), nil, repo)
+}
+
+// transferRepo moves a repository to newOwner, giving the teams of teamIDs
+// access when newOwner is an organization. When newOwner has to accept the
+// transfer first, Gitea answers 202 with the repository still at owner: this
+// is reported as an error since the repository did not move.
+func (c *Client) transferRepo(owner, name, newOwner string, teamIDs []int64) (*apiRepository, error) {
+	body := struct {
+		NewOwner string   `json:"new_owner"`
+		TeamIDs  *[]int64 `json:"team_ids,omitempty"`
+	}{NewOwner: newOwner}
+	if len(teamIDs) > 0 {
+		body.TeamIDs = &teamIDs
+	}
+	repo := new(apiRepository)
+	if err := c.apiRequest("POST", repoScope(owner, name)+"/transfer", body, repo); err != nil {
+		return nil, err
+	}
+	if repo.Owner == nil || !strings.EqualFold(repo.Owner.UserName, newOwner) {
+		return repo, fmt.Errorf("the transfer is pending until %s accepts it", newOwner)
+	}
+	return repo, nil
+}
+
+func (c *Client) editRepo(owner, name string, opt editRepoOption) (*apiRepository, error) {
+	repo := new(apiRepository)
+	return repo, c.apiRequest("PATCH", repoScope(owner, name), opt, repo)
+}
+
+// generateRepo creates a repository from the template repository
+// templateOwner/templateName
+func (c *Client) generateRepo(templateOwner, templateName string, opt generateRepoOption) (*apiRepository, error) {
+	repo := new(apiRepository)
+	return repo, c.apiRequest("POST", repoScope(templateOwner, templateName)+"/generate", opt, repo)
+}
+
+// getTree pages through the git tree of ref until Gitea no longer reports it
+// truncated. The tree stays truncated when a server ignores the page
+// parameter, answering another page than the one asked for or the same page
+// again.
+func (c *Client) getTree(owner, name, ref string, recursive bool) (*giteaapi.GitTreeResponse, error) {
+	var tree *giteaapi.GitTreeResponse
+	var previous string
+	for page := 1; ; page++ {
+		list := new(giteaapi.GitTreeResponse)
+		path := fmt.Sprintf("%s/git/trees/%s?recursive=%t&page=%d", repoScope(owner, name), url.PathEscape(ref), recursive, page)
+		if err := c.apiRequest("GET", path, nil, list); err != nil {
+			return nil, err
+		}
+		if tree == nil {
+			tree = list
+		} else {
+			if list.Page != page || len(list.Entries) == 0 || list.Entries[0].Path == previous {
+				return tree, nil
+			}
+			tree.Entries = append(tree.Entries, list.Entries...)
+			tree.Truncated = list.Truncated
+		}
+		if !list.Truncated || len(list.Entries) == 0 {
+			return tree, nil
+		}
+		previous = list.Entries[0].Path
+	}
+}
@@ -0,0 +1,82 @@
+package gitea
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestTransferRepo(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		owner   string
+		pending bool
+	}{
+		{name: "done", status: http.StatusCreated, owner: "target"},
+		{name: "pending", status: http.StatusAccepted, owner: "source", pending: true},
+	}
+
+	for _, c := range cases {
+		client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" || r.URL.Path != "/api/v1/repos/source/app/transfer" {
+				t.Errorf("%s: unexpected request %s %s", c.name, r.Method, r.URL.Path)
+			}
+			writeJSON(w, c.status, map[string]interface{}{
+				"name":  "app",
+				"owner": map[string]interface{}{"login": c.owner},
+			})
+		})
+		_, err := client.transferRepo("source", "app", "target", nil)
+		done()
+		if c.pending && err == nil {
+			t.Errorf("%s: expected a pending transfer error", c.name)
+		}
+		if !c.pending && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+	}
+}
+
+func TestGetTree(t *testing.T) {
+	pages := map[int]map[string]interface{}{
+		1: {"sha": "abc", "page": 1, "truncated": true, "tree": []map[string]interface{}{{"path": "a"}, {"path": "b"}}},
+		2: {"sha": "abc", "page": 2, "truncated": false, "tree": []map[string]interface{}{{"path": "c"}}},
+	}
+	cases := []struct {
+		name       string
+		ignorePage bool
+		entries    int
+		truncated  bool
+	}{
+		{name: "paged", entries: 3},
+		{name: "page ignored", ignorePage: true, entries: 2, truncated: true},
+	}
+
+	for _, c := range cases {
+		requests := 0
+		client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.URL.Path != "/api/v1/repos/acme/app/git/trees/main" {
+				t.Errorf("%s: unexpected request %s %s", c.name, r.Method, r.URL.Path)
+			}
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if c.ignorePage {
+				page = 1
+			}
+			writeJSON(w, http.StatusOK, pages[page])
+		})
+		tree, err := client.getTree("acme", "app", "main", true)
+		done()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if len(tree.Entries) != c.entries || tree.Truncated != c.truncated {
+			t.Errorf("%s: expected %d entries and truncated %t, got %d and %t", c.name, c.entries, c.truncated, len(tree.Entries), tree.Truncated)
+		}
+		if requests != 2 {
+			t.Errorf("%s: expected 2 requests, got %d", c.name, requests)
+		}
+	}
+}
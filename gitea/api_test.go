@@ -0,0 +1,28 @@
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+)
+
+// testAPIClient returns a client sending its API and SDK requests to handler
+// and the function closing the test server
+func testAPIClient(handler http.HandlerFunc) (*Client, func()) {
+	server := httptest.NewServer(handler)
+	client := &Client{
+		Client:     giteaapi.NewClientWithHTTP(server.URL, server.Client()),
+		baseURL:    server.URL,
+		httpClient: server.Client(),
+	}
+	return client, server.Close
+}
+
+// writeJSON answers a test request with value encoded as JSON
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,100 @@
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// apiUser is a Gitea user with the fields the SDK does not know about yet
+type apiUser struct {
+	ID                int64     `json:"id"`
+	UserName          string    `json:"login"`
+	LoginName         string    `json:"login_name"`
+	SourceID          int64     `json:"source_id"`
+	FullName          string    `json:"full_name"`
+	Email             string    `json:"email"`
+	AvatarURL         string    `json:"avatar_url"`
+	Language          string    `json:"language"`
+	IsAdmin           bool      `json:"is_admin"`
+	LastLogin         time.Time `json:"last_login"`
+	Created           time.Time `json:"created"`
+	Restricted        bool      `json:"restricted"`
+	Active            bool      `json:"active"`
+	ProhibitLogin     bool      `json:"prohibit_login"`
+	Location          string    `json:"location"`
+	Website           string    `json:"website"`
+	Description       string    `json:"description"`
+	Visibility        string    `json:"visibility"`
+	FollowersCount    int       `json:"followers_count"`
+	FollowingCount    int       `json:"following_count"`
+	StarredReposCount int       `json:"starred_repos_count"`
+}
+
+// getCurrentUser returns the owner of the token
+func (c *Client) getCurrentUser() (*apiUser, error) {
+	user := new(apiUser)
+	return user, c.apiRequest("GET", "/user", nil, user)
+}
+
+func (c *Client) getUser(username string) (*apiUser, error) {
+	user := new(apiUser)
+	return user, c.apiRequest("GET", "/users/"+url.PathEscape(username), nil, user)
+}
+
+// getUserByID looks a user up by numeric ID through the user search, which
+// is the only endpoint accepting one
+func (c *Client) getUserByID(id int64) (*apiUser, error) {
+	query := url.Values{}
+	query.Set("uid", fmt.Sprintf("%d", id))
+	users, err := c.searchUsers(query)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, &apiError{Method: "GET", Path: "/users/search", StatusCode: http.StatusNotFound, Message: fmt.Sprintf("user %d not found", id)}
+}
+
+// searchUsers pages through the user search endpoint with the given filters
+func (c *Client) searchUsers(query url.Values) ([]*apiUser, error) {
+	const limit = 50
+	users := []*apiUser{}
+	for page := 1; ; page++ {
+		query.Set("page", fmt.Sprintf("%d", page))
+		query.Set("limit", fmt.Sprintf("%d", limit))
+		result := struct {
+			Data []*apiUser `json:"data"`
+		}{}
+		if err := c.apiRequest("GET", "/users/search?"+query.Encode(), nil, &result); err != nil {
+			return nil, err
+		}
+		users = append(users, result.Data...)
+		if len(result.Data) < limit {
+			return users, nil
+		}
+	}
+}
+
+// adminListUsers pages through all the users of the instance, which requires
+// a site administrator token
+func (c *Client) adminListUsers(query url.Values) ([]*apiUser, error) {
+	const limit = 50
+	users := []*apiUser{}
+	for page := 1; ; page++ {
+		query.Set("page", fmt.Sprintf("%d", page))
+		query.Set("limit", fmt.Sprintf("%d", limit))
+		var list []*apiUser
+		if err := c.apiRequest("GET", "/admin/users?"+query.Encode(), nil, &list); err != nil {
+			return nil, err
+		}
+		users = append(users, list...)
+		if len(list) < limit {
+			return users, nil
+		}
+	}
+}
@@ -2,6 +2,8 @@ package gitea
 
 import (
 	"log"
+	"net/http"
+	"strings"
 
 	"code.gitea.io/sdk/gitea"
 )
@@ -12,8 +14,22 @@ type Config struct {
 	BaseURL string
 }
 
-// Client returns a *gitea.Client to interact with the configured Gitea instance
+// Client wraps the Gitea SDK client, adding direct access to the API
+// endpoints the SDK does not cover yet (see api.go)
+type Client struct {
+	*gitea.Client
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// Client returns a *Client to interact with the configured Gitea instance
 func (c *Config) Client() interface{} {
 	log.Printf("[DEBUG] Create client using configuration : %v", c)
-	return gitea.NewClient(c.BaseURL, c.Token)
+	return &Client{
+		Client:     gitea.NewClient(c.BaseURL, c.Token),
+		baseURL:    strings.TrimSuffix(c.BaseURL, "/"),
+		token:      c.Token,
+		httpClient: &http.Client{},
+	}
 }
@@ -0,0 +1,39 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaActionsRunnerToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaActionsRunnerTokenRead,
+		Schema: actionsRunnerScopeSchema(map[string]*schema.Schema{
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		}),
+	}
+}
+
+func dataSourceGiteaActionsRunnerTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	tokenPath, _, err := actionsRunnerScopePaths(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read actions runner registration token: %s", tokenPath)
+	token, err := client.getActionsRunnerToken(tokenPath)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve actions runner registration token: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(tokenPath)))
+	d.Set("token", token)
+	return nil
+}
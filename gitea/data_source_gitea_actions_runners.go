@@ -0,0 +1,90 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaActionsRunners() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaActionsRunnersRead,
+		Schema: actionsRunnerScopeSchema(map[string]*schema.Schema{
+			"runners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"busy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ephemeral": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceGiteaActionsRunnersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	_, runnersPath, err := actionsRunnerScopePaths(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read actions runners: %s", runnersPath)
+	runners, err := client.listActionsRunners(runnersPath)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve actions runners: %v", err)
+	}
+	log.Printf("[DEBUG] actions runners find: %v", runners)
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(runnersPath)))
+	d.Set("runners", flattenGiteaActionsRunners(runners))
+	return nil
+}
+
+func flattenGiteaActionsRunners(runners []*actionsRunner) []interface{} {
+	runnerList := []interface{}{}
+
+	for _, runner := range runners {
+		labels := []interface{}{}
+		for _, label := range runner.Labels {
+			labels = append(labels, label.Name)
+		}
+		values := map[string]interface{}{
+			"id":        runner.ID,
+			"name":      runner.Name,
+			"status":    runner.Status,
+			"busy":      runner.Busy,
+			"ephemeral": runner.Ephemeral,
+			"labels":    labels,
+		}
+
+		runnerList = append(runnerList, values)
+	}
+	return runnerList
+}
@@ -0,0 +1,124 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaCombinedStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaCombinedStatusRead,
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"statuses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"context": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGiteaCombinedStatusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	ref := d.Get("ref").(string)
+
+	log.Printf("[DEBUG] read combined status %s/%s@%s", owner, repository, ref)
+	combined, err := client.GetCombinedStatus(owner, repository, ref)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve combined status of %s/%s@%s: %v", owner, repository, ref, err)
+	}
+	log.Printf("[DEBUG] combined status find: %v", combined)
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s/%s@%s", owner, repository, combined.SHA))))
+	d.Set("sha", combined.SHA)
+	d.Set("state", string(combined.State))
+	d.Set("total_count", combined.TotalCount)
+	d.Set("statuses", flattenGiteaStatuses(combined.Statuses))
+	return nil
+}
+
+func flattenGiteaStatuses(statuses []*giteaapi.Status) []interface{} {
+	statusList := []interface{}{}
+
+	for _, status := range statuses {
+		values := map[string]interface{}{
+			"id":          status.ID,
+			"context":     status.Context,
+			"state":       string(status.State),
+			"target_url":  status.TargetURL,
+			"description": status.Description,
+			"created":     status.Created.String(),
+			"updated":     status.Updated.String(),
+		}
+		if status.Creator != nil {
+			values["creator"] = status.Creator.UserName
+		}
+
+		statusList = append(statusList, values)
+	}
+	return statusList
+}
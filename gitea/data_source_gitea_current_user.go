@@ -0,0 +1,55 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaCurrentUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaCurrentUserRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fullname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGiteaCurrentUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	log.Printf("[INFO] Reading Gitea current user")
+
+	user, err := client.getCurrentUser()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the user of the token: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", user.ID))
+	d.Set("user_id", user.ID)
+	d.Set("username", user.UserName)
+	d.Set("fullname", user.FullName)
+	d.Set("email", user.Email)
+	d.Set("is_admin", user.IsAdmin)
+	return nil
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 	"strings"
 
-	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -46,7 +45,7 @@ func dataSourceGiteaOrganization() *schema.Resource {
 }
 
 func dataSourceGiteaOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	username := strings.ToLower(d.Get("username").(string))
 	log.Printf("[DEBUG] read organization %q %s", d.Id(), username)
 	org, err := client.GetOrg(username)
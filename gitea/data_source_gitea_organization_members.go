@@ -0,0 +1,44 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaOrganizationMembersRead,
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGiteaOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	log.Printf("[DEBUG] read members of organization %s", org)
+
+	members, err := client.listOrgMembers(org)
+	if err != nil {
+		return fmt.Errorf("unable to list members of organization %s: %v", org, err)
+	}
+
+	usernames := []string{}
+	for _, member := range members {
+		usernames = append(usernames, member.UserName)
+	}
+	d.Set("members", usernames)
+	d.SetId(org)
+	return nil
+}
@@ -61,7 +61,7 @@ func dataSourceGiteaOrganizations() *schema.Resource {
 }
 
 func dataSourceGiteaOrganizationsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	options := giteaapi.ListOrgsOptions{}
 	if data, ok := d.GetOk("username"); ok {
 		username := data.(string)
@@ -71,7 +71,7 @@ func dataSourceGiteaOrganizationsRead(d *schema.ResourceData, meta interface{})
 		}
 		log.Printf("[DEBUG] organization find: %v", orgs)
 		d.Set("organizations", flattenGiteaOrganizations(orgs))
-		d.SetId(fmt.Sprintf("%d", orgs))
+		d.SetId(fmt.Sprintf("%d", schema.HashString(username)))
 	} else {
 		orgs, err := client.ListMyOrgs(options)
 		if err != nil {
@@ -79,7 +79,7 @@ func dataSourceGiteaOrganizationsRead(d *schema.ResourceData, meta interface{})
 		}
 		log.Printf("[DEBUG] organizations find: %v", orgs)
 		d.Set("organizations", flattenGiteaOrganizations(orgs))
-		d.SetId(fmt.Sprintf("%d", orgs))
+		d.SetId(fmt.Sprintf("%d", schema.HashString("")))
 	}
 
 	return nil
@@ -3,9 +3,11 @@ package gitea
 import (
 	"fmt"
 	"log"
+	"net/url"
 
 	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func dataSourceGiteaRepositories() *schema.Resource {
@@ -15,9 +17,61 @@ func dataSourceGiteaRepositories() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"owner": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
+			"search": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"topic": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"include_description": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"visibility": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+						},
+						"archived": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"fork", "source", "mirror", "collaborative"}, false),
+						},
+						// unset leaves it to Gitea, which includes templates
+						"template": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"sort": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"alpha", "created", "updated", "size", "id", "stars", "forks"}, false),
+						},
+						"order": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+						},
+					},
+				},
+			},
 			"repositories": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -131,22 +185,108 @@ func dataSourceGiteaRepositories() *schema.Resource {
 }
 
 func dataSourceGiteaRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
-	id := schema.HashString(owner)
-	options := giteaapi.ListReposOptions{}
-	repos, err := client.ListUserRepos(owner, options)
-	if err != nil {
-		return fmt.Errorf("unable to retrieve repositories for %s", owner)
+
+	var repos []*giteaapi.Repository
+	var err error
+	if searches := d.Get("search").([]interface{}); len(searches) > 0 {
+		search := map[string]interface{}{}
+		if searches[0] != nil {
+			search = searches[0].(map[string]interface{})
+		}
+		repos, err = dataSourceGiteaRepositoriesSearch(client, owner, search)
+		if err != nil {
+			return err
+		}
+		d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s/%v", owner, search))))
+	} else {
+		if owner == "" {
+			return fmt.Errorf("one of owner or search must be set")
+		}
+		repos, err = dataSourceGiteaRepositoriesList(client, owner)
+		if err != nil {
+			return err
+		}
+		d.SetId(fmt.Sprintf("%d", schema.HashString(owner)))
 	}
 
 	log.Printf("[DEBUG] repositories find: %v", repos)
 	d.Set("repositories", flattenGiteaRepositories(repos))
-	d.SetId(fmt.Sprintf("%d", id))
 
 	return nil
 }
 
+// dataSourceGiteaRepositoriesList lists all the repositories of owner, which
+// can be a user or an organization
+func dataSourceGiteaRepositoriesList(client *Client, owner string) ([]*giteaapi.Repository, error) {
+	_, err := client.GetOrg(owner)
+	if err == nil {
+		repos, err := client.listOrgRepos(owner)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve repositories for %s: %v", owner, err)
+		}
+		return repos, nil
+	}
+	if !isNotFoundErr(err) {
+		return nil, fmt.Errorf("unable to retrieve organization %s: %v", owner, err)
+	}
+	repos, err := client.listUserRepos(owner)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve repositories for %s: %v", owner, err)
+	}
+	return repos, nil
+}
+
+// dataSourceGiteaRepositoriesSearch runs the repository search with the
+// filters of the search block, restricted to owner when set
+func dataSourceGiteaRepositoriesSearch(client *Client, owner string, search map[string]interface{}) ([]*giteaapi.Repository, error) {
+	query := url.Values{}
+	if owner != "" {
+		user, err := client.GetUserInfo(owner)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve owner %s: %v", owner, err)
+		}
+		query.Set("uid", fmt.Sprintf("%d", user.ID))
+		query.Set("exclusive", "true")
+	}
+	if q, ok := search["query"].(string); ok && q != "" {
+		query.Set("q", q)
+	}
+	if topic, ok := search["topic"].(bool); ok && topic {
+		query.Set("topic", "true")
+	}
+	if includeDesc, ok := search["include_description"].(bool); ok && includeDesc {
+		query.Set("includeDesc", "true")
+	}
+	switch search["visibility"] {
+	case "private":
+		query.Set("is_private", "true")
+	case "public":
+		query.Set("is_private", "false")
+	}
+	if archived, ok := search["archived"].(string); ok && archived != "" {
+		query.Set("archived", archived)
+	}
+	if mode, ok := search["mode"].(string); ok && mode != "" {
+		query.Set("mode", mode)
+	}
+	if template, ok := search["template"].(string); ok && template != "" {
+		query.Set("template", template)
+	}
+	if sort, ok := search["sort"].(string); ok && sort != "" {
+		query.Set("sort", sort)
+	}
+	if order, ok := search["order"].(string); ok && order != "" {
+		query.Set("order", order)
+	}
+
+	repos, err := client.searchRepos(query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to search repositories: %v", err)
+	}
+	return repos, nil
+}
 
 func flattenGiteaRepositories(repos []*giteaapi.Repository) []interface{} {
 	repoList := []interface{}{}
@@ -174,14 +314,20 @@ func flattenGiteaRepositories(repos []*giteaapi.Repository) []interface{} {
 			"default_branch":  repo.DefaultBranch,
 			"created":  fmt.Sprintf("%v", repo.Created),
 			"updated":  fmt.Sprintf("%v", repo.Updated),
-			"permission_admin":  repo.Permissions.Admin,
-			"permission_push":  repo.Permissions.Push,
-			"permission_pull":  repo.Permissions.Pull,
+		}
+
+		// search results of anonymous requests carry no permissions
+		if repo.Permissions != nil {
+			values["permission_admin"] = repo.Permissions.Admin
+			values["permission_push"] = repo.Permissions.Push
+			values["permission_pull"] = repo.Permissions.Pull
 		}
 
 		if repo.Parent != nil {
-			values["parent_username"] = repo.Parent
-			values["parent_name"] = repo.Parent
+			if repo.Parent.Owner != nil {
+				values["parent_username"] = repo.Parent.Owner.UserName
+			}
+			values["parent_name"] = repo.Parent.Name
 		}
 
 		repoList = append(repoList, values)
@@ -0,0 +1,42 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaRepositoriesByTopic() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaRepositoriesByTopicRead,
+
+		Schema: map[string]*schema.Schema{
+			"topic": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repositories": dataSourceGiteaRepositories().Schema["repositories"],
+		},
+	}
+}
+
+func dataSourceGiteaRepositoriesByTopicRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	topic := d.Get("topic").(string)
+
+	query := url.Values{}
+	query.Set("q", topic)
+	query.Set("topic", "true")
+	repos, err := client.searchRepos(query)
+	if err != nil {
+		return fmt.Errorf("unable to search repositories with topic %s: %v", topic, err)
+	}
+
+	log.Printf("[DEBUG] repositories find: %v", repos)
+	d.Set("repositories", flattenGiteaRepositories(repos))
+	d.SetId(fmt.Sprintf("%d", schema.HashString(topic)))
+
+	return nil
+}
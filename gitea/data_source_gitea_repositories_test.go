@@ -0,0 +1,67 @@
+package gitea
+
+import (
+	"net/http"
+	"testing"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+)
+
+func TestFlattenGiteaRepositories(t *testing.T) {
+	repos := flattenGiteaRepositories([]*giteaapi.Repository{
+		{ID: 1, Name: "anonymous"},
+		{
+			ID:          2,
+			Name:        "fork",
+			Permissions: &giteaapi.Permission{Admin: true, Push: true, Pull: true},
+			Parent:      &giteaapi.Repository{Name: "upstream", Owner: &giteaapi.User{UserName: "acme"}},
+		},
+		{ID: 3, Name: "orphan", Parent: &giteaapi.Repository{Name: "gone"}},
+	})
+	if len(repos) != 3 {
+		t.Fatalf("expected 3 repositories, got %d", len(repos))
+	}
+
+	anonymous := repos[0].(map[string]interface{})
+	for _, key := range []string{"permission_admin", "parent_name", "parent_username"} {
+		if _, ok := anonymous[key]; ok {
+			t.Errorf("expected no %s without permissions and parent, got %v", key, anonymous[key])
+		}
+	}
+
+	fork := repos[1].(map[string]interface{})
+	if fork["permission_admin"] != true || fork["parent_name"] != "upstream" || fork["parent_username"] != "acme" {
+		t.Errorf("unexpected fork %v", fork)
+	}
+
+	orphan := repos[2].(map[string]interface{})
+	if _, ok := orphan["parent_username"]; ok || orphan["parent_name"] != "gone" {
+		t.Errorf("unexpected orphan %v", orphan)
+	}
+}
+
+func TestDataSourceGiteaRepositoriesSearchTemplate(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		expected []string
+	}{
+		{name: "unset", template: "", expected: nil},
+		{name: "false", template: "false", expected: []string{"false"}},
+		{name: "true", template: "true", expected: []string{"true"}},
+	}
+
+	for _, c := range cases {
+		client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query()["template"]; len(got) != len(c.expected) || (len(got) == 1 && got[0] != c.expected[0]) {
+				t.Errorf("%s: expected template %v, got %v", c.name, c.expected, got)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"data": []interface{}{}})
+		})
+		_, err := dataSourceGiteaRepositoriesSearch(client, "", map[string]interface{}{"template": c.template})
+		done()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -156,7 +155,7 @@ func dataSourceGiteaRepository() *schema.Resource {
 }
 
 func dataSourceGiteaRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 
 	username := strings.ToLower(d.Get("username").(string))
 	name := d.Get("name").(string)
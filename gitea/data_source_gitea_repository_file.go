@@ -0,0 +1,107 @@
+package gitea
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaRepositoryFileRead,
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// branch, tag or commit, the default branch when empty
+			"ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encoding": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGiteaRepositoryFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	path := d.Get("path").(string)
+	ref, err := dataSourceGiteaRepositoryRef(client, owner, repository, d.Get("ref").(string))
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] read file %s of %s/%s at %s", path, owner, repository, ref)
+
+	contents, err := client.GetContents(owner, repository, ref, path)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve file %s of %s/%s at %s: %v", path, owner, repository, ref, err)
+	}
+	if contents.Type != "file" {
+		return fmt.Errorf("%s of %s/%s at %s is a %s, not a file", path, owner, repository, ref, contents.Type)
+	}
+
+	if contents.Content == nil {
+		return fmt.Errorf("Gitea returned no content for file %s of %s/%s at %s", path, owner, repository, ref)
+	}
+	content, err := base64.StdEncoding.DecodeString(*contents.Content)
+	if err != nil {
+		return fmt.Errorf("unable to decode file %s of %s/%s at %s: %v", path, owner, repository, ref, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", owner, repository, ref, path))
+	d.Set("ref", ref)
+	d.Set("content", string(content))
+	d.Set("content_base64", base64.StdEncoding.EncodeToString(content))
+	if contents.Encoding != nil {
+		d.Set("encoding", *contents.Encoding)
+	}
+	d.Set("sha", contents.SHA)
+	d.Set("size", contents.Size)
+	return nil
+}
+
+// dataSourceGiteaRepositoryRef returns ref, or the default branch of the
+// repository when ref is empty
+func dataSourceGiteaRepositoryRef(client *Client, owner, repository, ref string) (string, error) {
+	if ref != "" {
+		return ref, nil
+	}
+	repo, err := client.getRepo(owner, repository)
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve repository %s/%s: %v", owner, repository, err)
+	}
+	return repo.DefaultBranch, nil
+}
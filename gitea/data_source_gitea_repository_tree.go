@@ -0,0 +1,109 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaRepositoryTree() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaRepositoryTreeRead,
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// branch, tag, commit or tree sha, the default branch when empty
+			"ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// true when paging through the tree could not fetch every entry
+			"truncated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGiteaRepositoryTreeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	ref, err := dataSourceGiteaRepositoryRef(client, owner, repository, d.Get("ref").(string))
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] read tree of %s/%s at %s", owner, repository, ref)
+
+	tree, err := client.getTree(owner, repository, ref, d.Get("recursive").(bool))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve tree of %s/%s at %s: %v", owner, repository, ref, err)
+	}
+
+	entries := []interface{}{}
+	for _, entry := range tree.Entries {
+		entries = append(entries, map[string]interface{}{
+			"path": entry.Path,
+			"mode": entry.Mode,
+			"type": entry.Type,
+			"size": entry.Size,
+			"sha":  entry.SHA,
+		})
+	}
+	if tree.Truncated {
+		log.Printf("[WARN] tree of %s/%s at %s is truncated, %d of %d entries returned", owner, repository, ref, len(entries), tree.TotalCount)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repository, tree.SHA))
+	d.Set("ref", ref)
+	d.Set("sha", tree.SHA)
+	d.Set("truncated", tree.Truncated)
+	d.Set("entries", entries)
+	return nil
+}
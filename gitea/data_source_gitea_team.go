@@ -0,0 +1,57 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaTeam() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"organization": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	for key, value := range dataSourceGiteaTeams().Schema["teams"].Elem.(*schema.Resource).Schema {
+		if key != "id" {
+			s[key] = value
+		}
+	}
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	return &schema.Resource{
+		Read:   dataSourceGiteaTeamRead,
+		Schema: s,
+	}
+}
+
+func dataSourceGiteaTeamRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] read team %s of organization %s", name, org)
+
+	team, err := client.findOrgTeam(org, name)
+	if err != nil {
+		return fmt.Errorf("unable to list teams of organization %s: %v", org, err)
+	}
+	if team == nil {
+		return fmt.Errorf("organization %s has no team %s", org, name)
+	}
+
+	values, err := flattenGiteaTeam(client, team)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%d", team.ID))
+	for key, value := range values {
+		if key != "id" {
+			d.Set(key, value)
+		}
+	}
+	return nil
+}
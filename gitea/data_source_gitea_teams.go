@@ -0,0 +1,103 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGiteaTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaTeamsRead,
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"units": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"includes_all_repositories": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"can_create_org_repo": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"member_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGiteaTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	log.Printf("[DEBUG] read teams of organization %s", org)
+
+	teams, err := client.listOrgTeams(org)
+	if err != nil {
+		return fmt.Errorf("unable to list teams of organization %s: %v", org, err)
+	}
+
+	teamsList := []interface{}{}
+	for _, team := range teams {
+		values, err := flattenGiteaTeam(client, team)
+		if err != nil {
+			return err
+		}
+		teamsList = append(teamsList, values)
+	}
+	d.Set("teams", teamsList)
+	d.SetId(org)
+	return nil
+}
+
+// flattenGiteaTeam returns the attributes of a team, counting its members as
+// Gitea does not return their number
+func flattenGiteaTeam(client *Client, team *apiTeam) (map[string]interface{}, error) {
+	members, err := client.listTeamMembers(team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list members of team %s: %v", team.Name, err)
+	}
+	return map[string]interface{}{
+		"id":                        team.ID,
+		"name":                      team.Name,
+		"description":               team.Description,
+		"permission":                team.Permission,
+		"units":                     team.Units,
+		"includes_all_repositories": team.IncludesAllRepositories,
+		"can_create_org_repo":       team.CanCreateOrgRepo,
+		"member_count":              len(members),
+	}, nil
+}
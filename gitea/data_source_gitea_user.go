@@ -3,7 +3,7 @@ package gitea
 import (
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
 	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,8 +14,16 @@ func dataSourceGiteaUser() *schema.Resource {
 		Read: dataSourceGiteaUserRead,
 		Schema: map[string]*schema.Schema{
 			"username": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_id"},
+			},
+			"user_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username"},
 			},
 			"fullname": {
 				Type:     schema.TypeString,
@@ -29,25 +37,108 @@ func dataSourceGiteaUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_login": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"followers_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"following_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"starred_repos_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"organizations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
 func dataSourceGiteaUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 
 	log.Printf("[INFO] Reading Gitea user")
 
-	userName := strings.ToLower(d.Get("username").(string))
-	user, err := client.GetUserInfo(userName)
+	var user *apiUser
+	var err error
+	userName := d.Get("username").(string)
+	userID := int64(d.Get("user_id").(int))
+	switch {
+	case userName != "":
+		user, err = client.getUser(userName)
+	case userID != 0:
+		user, err = client.getUserByID(userID)
+	default:
+		return fmt.Errorf("one of username or user_id must be set")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve user: %v", err)
+	}
+
+	orgs, err := dataSourceGiteaUserOrganizations(client, user.UserName)
 	if err != nil {
 		return err
 	}
 
 	d.SetId(fmt.Sprintf("%d", user.ID))
 	d.Set("username", user.UserName)
+	d.Set("user_id", user.ID)
 	d.Set("fullname", user.FullName)
 	d.Set("email", user.Email)
 	d.Set("avatar_url", user.AvatarURL)
+	d.Set("is_admin", user.IsAdmin)
+	d.Set("created", user.Created.Format(time.RFC3339))
+	d.Set("last_login", user.LastLogin.Format(time.RFC3339))
+	d.Set("language", user.Language)
+	d.Set("visibility", user.Visibility)
+	d.Set("followers_count", user.FollowersCount)
+	d.Set("following_count", user.FollowingCount)
+	d.Set("starred_repos_count", user.StarredReposCount)
+	d.Set("organizations", orgs)
 	return nil
 }
+
+// dataSourceGiteaUserOrganizations returns the names of all the organizations
+// of a user
+func dataSourceGiteaUserOrganizations(client *Client, username string) ([]string, error) {
+	names := []string{}
+	for page := 1; ; page++ {
+		options := giteaapi.ListOrgsOptions{
+			ListOptions: giteaapi.ListOptions{Page: page, PageSize: 50},
+		}
+		orgs, err := client.ListUserOrgs(username, options)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve organizations for %s: %v", username, err)
+		}
+		for _, org := range orgs {
+			names = append(names, org.UserName)
+		}
+		if len(orgs) < options.PageSize {
+			return names, nil
+		}
+	}
+}
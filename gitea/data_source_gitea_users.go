@@ -0,0 +1,188 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceGiteaUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaUsersRead,
+		Schema: map[string]*schema.Schema{
+			// search uses the public user search, admin lists every user of
+			// the instance and requires a site administrator token
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "search",
+				ValidateFunc: validation.StringInSlice([]string{"search", "admin"}, false),
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// the authentication source of the users, 0 being local users.
+			// Like active, is_admin and restricted, it only filters in admin
+			// mode.
+			"login_source_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"active": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			},
+			"is_admin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			},
+			"restricted": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"login_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"login_source_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fullname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_admin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restricted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGiteaUsersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	mode := d.Get("mode").(string)
+	q := d.Get("query").(string)
+	sourceID, filterSource := d.GetOkExists("login_source_id")
+
+	// the public search does not return these fields, filtering on them
+	// would silently drop every user
+	if mode != "admin" && (filterSource || d.Get("active").(string) != "" ||
+		d.Get("is_admin").(string) != "" || d.Get("restricted").(string) != "") {
+		return fmt.Errorf("login_source_id, active, is_admin and restricted can only filter users when mode is admin")
+	}
+
+	var users []*apiUser
+	var err error
+	if mode == "admin" {
+		query := url.Values{}
+		if filterSource {
+			query.Set("source_id", fmt.Sprintf("%d", sourceID.(int)))
+		}
+		log.Printf("[DEBUG] list users: %v", query)
+		users, err = client.adminListUsers(query)
+	} else {
+		query := url.Values{}
+		query.Set("q", q)
+		log.Printf("[DEBUG] search users: %v", query)
+		users, err = client.searchUsers(query)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve users: %v", err)
+	}
+
+	usersList := []interface{}{}
+	for _, user := range users {
+		if mode == "admin" && q != "" && !dataSourceGiteaUsersMatch(user, q) {
+			continue
+		}
+		if filterSource && user.SourceID != int64(sourceID.(int)) {
+			continue
+		}
+		if !matchBoolFilter(d.Get("active").(string), user.Active) ||
+			!matchBoolFilter(d.Get("is_admin").(string), user.IsAdmin) ||
+			!matchBoolFilter(d.Get("restricted").(string), user.Restricted) {
+			continue
+		}
+		usersList = append(usersList, map[string]interface{}{
+			"id":              user.ID,
+			"username":        user.UserName,
+			"login_name":      user.LoginName,
+			"login_source_id": user.SourceID,
+			"fullname":        user.FullName,
+			"email":           user.Email,
+			"active":          user.Active,
+			"is_admin":        user.IsAdmin,
+			"restricted":      user.Restricted,
+			"created":         user.Created.Format(time.RFC3339),
+			"last_login":      user.LastLogin.Format(time.RFC3339),
+		})
+	}
+
+	log.Printf("[DEBUG] users find: %d", len(usersList))
+	d.Set("users", usersList)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s/%s/%v/%s/%s/%s", mode, q, sourceID,
+		d.Get("active"), d.Get("is_admin"), d.Get("restricted")))))
+	return nil
+}
+
+// dataSourceGiteaUsersMatch applies the query to the admin listing, which
+// does not filter by keyword, the way the user search does
+func dataSourceGiteaUsersMatch(user *apiUser, q string) bool {
+	q = strings.ToLower(q)
+	return strings.Contains(strings.ToLower(user.UserName), q) ||
+		strings.Contains(strings.ToLower(user.FullName), q) ||
+		strings.Contains(strings.ToLower(user.Email), q)
+}
+
+// matchBoolFilter reports whether value passes a "true", "false" or empty,
+// meaning any, filter
+func matchBoolFilter(filter string, value bool) bool {
+	return filter == "" || filter == fmt.Sprintf("%t", value)
+}
@@ -0,0 +1,60 @@
+package gitea
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMatchBoolFilter(t *testing.T) {
+	cases := []struct {
+		filter   string
+		value    bool
+		expected bool
+	}{
+		{"", true, true},
+		{"", false, true},
+		{"true", true, true},
+		{"true", false, false},
+		{"false", false, true},
+		{"false", true, false},
+	}
+	for _, c := range cases {
+		if got := matchBoolFilter(c.filter, c.value); got != c.expected {
+			t.Errorf("filter %q on %t: expected %t, got %t", c.filter, c.value, c.expected, got)
+		}
+	}
+}
+
+func TestDataSourceGiteaUsersMatch(t *testing.T) {
+	user := &apiUser{}
+	user.UserName = "johndoe"
+	user.FullName = "John Doe"
+	user.Email = "john.doe@gitea.io"
+
+	for _, q := range []string{"JOHN", "doe@", "n D", "gitea.io"} {
+		if !dataSourceGiteaUsersMatch(user, q) {
+			t.Errorf("expected %q to match", q)
+		}
+	}
+	for _, q := range []string{"jane", "example.com"} {
+		if dataSourceGiteaUsersMatch(user, q) {
+			t.Errorf("expected %q not to match", q)
+		}
+	}
+}
+
+func TestDataSourceGiteaUsersAdminOnlyFilters(t *testing.T) {
+	for _, filter := range []map[string]interface{}{
+		{"login_source_id": 0},
+		{"active": "true"},
+		{"is_admin": "false"},
+		{"restricted": "true"},
+	} {
+		d := schema.TestResourceDataRaw(t, dataSourceGiteaUsers().Schema, filter)
+		if err := dataSourceGiteaUsersRead(d, &Client{}); err == nil || !strings.Contains(err.Error(), "mode is admin") {
+			t.Errorf("expected %v to be rejected in search mode", filter)
+		}
+	}
+}
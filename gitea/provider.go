@@ -36,20 +36,42 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"gitea_organization":      resourceGiteaOrganization(),
-			"gitea_organization_hook": resourceGiteaOrganizationHook(),
-			"gitea_user":              resourceGiteaUser(),
-			"gitea_repository":        resourceGiteaRepository(),
-			"gitea_repository_hook":   resourceGiteaRepositoryHook(),
-			"gitea_label":             resourceGiteaLabel(),
-			"gitea_milestone":         resourceGiteaMilestone(),
+			"gitea_organization":                  resourceGiteaOrganization(),
+			"gitea_organization_hook":             resourceGiteaOrganizationHook(),
+			"gitea_user":                          resourceGiteaUser(),
+			"gitea_repository":                    resourceGiteaRepository(),
+			"gitea_repository_hook":               resourceGiteaRepositoryHook(),
+			"gitea_label":                         resourceGiteaLabel(),
+			"gitea_milestone":                     resourceGiteaMilestone(),
+			"gitea_commit_status":                 resourceGiteaCommitStatus(),
+			"gitea_repository_actions_secret":     resourceGiteaRepositoryActionsSecret(),
+			"gitea_repository_actions_variable":   resourceGiteaRepositoryActionsVariable(),
+			"gitea_organization_actions_secret":   resourceGiteaOrganizationActionsSecret(),
+			"gitea_organization_actions_variable": resourceGiteaOrganizationActionsVariable(),
+			"gitea_system_hook":                   resourceGiteaSystemHook(),
+			"gitea_organization_membership":       resourceGiteaOrganizationMembership(),
+			"gitea_organization_members":          resourceGiteaOrganizationMembers(),
+			"gitea_organization_block":            resourceGiteaOrganizationBlock(),
+			"gitea_user_block":                    resourceGiteaUserBlock(),
+			"gitea_actions_runner_deregistration": resourceGiteaActionsRunnerDeregistration(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"gitea_user":              dataSourceGiteaUser(),
-			"gitea_repository":        dataSourceGiteaRepository(),
-			"gitea_repositories":      dataSourceGiteaRepositories(),
-			"gitea_organization":      dataSourceGiteaOrganization(),
-			"gitea_organizations":     dataSourceGiteaOrganizations(),
+			"gitea_user":                  dataSourceGiteaUser(),
+			"gitea_repository":            dataSourceGiteaRepository(),
+			"gitea_repositories":          dataSourceGiteaRepositories(),
+			"gitea_organization":          dataSourceGiteaOrganization(),
+			"gitea_organizations":         dataSourceGiteaOrganizations(),
+			"gitea_combined_status":       dataSourceGiteaCombinedStatus(),
+			"gitea_actions_runner_token":  dataSourceGiteaActionsRunnerToken(),
+			"gitea_actions_runners":       dataSourceGiteaActionsRunners(),
+			"gitea_repositories_by_topic": dataSourceGiteaRepositoriesByTopic(),
+			"gitea_organization_members":  dataSourceGiteaOrganizationMembers(),
+			"gitea_team":                  dataSourceGiteaTeam(),
+			"gitea_teams":                 dataSourceGiteaTeams(),
+			"gitea_users":                 dataSourceGiteaUsers(),
+			"gitea_current_user":          dataSourceGiteaCurrentUser(),
+			"gitea_repository_file":       dataSourceGiteaRepositoryFile(),
+			"gitea_repository_tree":       dataSourceGiteaRepositoryTree(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
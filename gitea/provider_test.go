@@ -4,14 +4,13 @@ import (
 	"os"
 	"testing"
 
-	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
-var testAccGiteaClient *giteaapi.Client
+var testAccGiteaClient *Client
 
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
@@ -43,6 +42,6 @@ func testAccPreCheck(t *testing.T) {
 			Token:   os.Getenv(ENV_GITEA_TOKEN),
 		}
 
-		testAccGiteaClient = config.Client().(*giteaapi.Client)
+		testAccGiteaClient = config.Client().(*Client)
 	}
 }
@@ -0,0 +1,56 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceGiteaActionsRunnerDeregistration removes a runner from Gitea when
+// created, typically a stale runner found with the gitea_actions_runners data
+// source. Destroying it does nothing, the runner being gone already.
+func resourceGiteaActionsRunnerDeregistration() *schema.Resource {
+	s := actionsRunnerScopeSchema(map[string]*schema.Schema{
+		"runner_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+	})
+	for _, attribute := range s {
+		attribute.ForceNew = true
+	}
+	return &schema.Resource{
+		Create: resourceGiteaActionsRunnerDeregistrationCreate,
+		Read:   resourceGiteaActionsRunnerDeregistrationRead,
+		Delete: resourceGiteaActionsRunnerDeregistrationDelete,
+		Schema: s,
+	}
+}
+
+func resourceGiteaActionsRunnerDeregistrationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	_, runnersPath, err := actionsRunnerScopePaths(d)
+	if err != nil {
+		return err
+	}
+	id := int64(d.Get("runner_id").(int))
+
+	log.Printf("[DEBUG] deregister actions runner: %s %d", runnersPath, id)
+	err = client.deleteActionsRunner(runnersPath, id)
+	if err != nil && !isNotFoundErr(err) {
+		return fmt.Errorf("unable to deregister actions runner %d: %v", id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", runnersPath, id))
+	return nil
+}
+
+func resourceGiteaActionsRunnerDeregistrationRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceGiteaActionsRunnerDeregistrationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] forget deregistration of actions runner %s", d.Id())
+	return nil
+}
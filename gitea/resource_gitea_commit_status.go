@@ -0,0 +1,210 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	giteaapi "code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var commitStatusStates = []string{
+	string(giteaapi.StatusPending),
+	string(giteaapi.StatusSuccess),
+	string(giteaapi.StatusError),
+	string(giteaapi.StatusFailure),
+	string(giteaapi.StatusWarning),
+}
+
+func resourceGiteaCommitStatus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaCommitStatusCreate,
+		Read:   resourceGiteaCommitStatusRead,
+		Delete: resourceGiteaCommitStatusDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaCommitStatusImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(commitStatusStates, false),
+			},
+			"target_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGiteaCommitStatusSetToState(d *schema.ResourceData, status *giteaapi.Status) {
+	d.Set("context", status.Context)
+	d.Set("state", string(status.State))
+	d.Set("target_url", status.TargetURL)
+	d.Set("description", status.Description)
+	d.Set("url", status.URL)
+	if status.Creator != nil {
+		d.Set("creator", status.Creator.UserName)
+	}
+	d.Set("created", status.Created.String())
+	d.Set("updated", status.Updated.String())
+}
+
+func resourceGiteaCommitStatusCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	sha := d.Get("sha").(string)
+	options := giteaapi.CreateStatusOption{
+		State:       giteaapi.StatusState(d.Get("state").(string)),
+		TargetURL:   d.Get("target_url").(string),
+		Description: d.Get("description").(string),
+		Context:     d.Get("context").(string),
+	}
+
+	log.Printf("[DEBUG] create commit status: %s %s %s %v", owner, repository, sha, options)
+
+	status, err := client.CreateStatus(owner, repository, sha, options)
+	if err != nil {
+		return fmt.Errorf("unable to create commit status on %s/%s@%s: %v", owner, repository, sha, err)
+	}
+	log.Printf("[DEBUG] commit status created %v", status)
+	d.SetId(strconv.FormatInt(status.ID, 10))
+	return resourceGiteaCommitStatusRead(d, meta)
+}
+
+func resourceGiteaCommitStatusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	statusId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	sha := d.Get("sha").(string)
+	log.Printf("[DEBUG] read commit status %d on %s/%s@%s", statusId, owner, repository, sha)
+
+	status, err := findGiteaCommitStatus(client, owner, repository, sha, statusId)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		log.Printf("[WARN] commit status %d not found on %s/%s@%s, removing from state", statusId, owner, repository, sha)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] commit status find %v", status)
+	resourceGiteaCommitStatusSetToState(d, status)
+	return nil
+}
+
+func resourceGiteaCommitStatusDelete(d *schema.ResourceData, meta interface{}) error {
+	// Gitea keeps the full status history of a commit and has no API to
+	// remove a status, so destroying only forgets it.
+	log.Printf("[DEBUG] forget commit status %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceGiteaCommitStatusImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {owner}/{repo}/{sha}/{id}", d.Id())
+	}
+
+	client := meta.(*Client)
+	owner := parts[0]
+	repository := parts[1]
+	sha := parts[2]
+	statusId, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, unconvertibleIdErr(parts[3], err)
+	}
+
+	status, err := findGiteaCommitStatus(client, owner, repository, sha, statusId)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return nil, fmt.Errorf("unable to retrieve commit status %s %s %s %d", owner, repository, sha, statusId)
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repository)
+	d.Set("sha", sha)
+	d.SetId(fmt.Sprintf("%d", statusId))
+	resourceGiteaCommitStatusSetToState(d, status)
+	return []*schema.ResourceData{d}, nil
+}
+
+// findGiteaCommitStatus walks every page of statuses of a commit looking for
+// the given status id, returning nil when it does not exist.
+func findGiteaCommitStatus(client *Client, owner, repository, sha string, id int64) (*giteaapi.Status, error) {
+	options := giteaapi.ListStatusesOption{
+		ListOptions: giteaapi.ListOptions{Page: 1, PageSize: 50},
+	}
+	for {
+		statuses, err := client.ListStatuses(owner, repository, sha, options)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list commit statuses of %s/%s@%s: %v", owner, repository, sha, err)
+		}
+		for _, status := range statuses {
+			if status.ID == id {
+				return status, nil
+			}
+		}
+		if len(statuses) < options.PageSize {
+			return nil, nil
+		}
+		options.Page++
+	}
+}
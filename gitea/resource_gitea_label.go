@@ -47,7 +47,7 @@ func resourceGiteaLabelSetToState(d *schema.ResourceData, label *giteaapi.Label)
 }
 
 func resourceGiteaLabelCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
 	repository := d.Get("repository").(string)
 	options := giteaapi.CreateLabelOption{
@@ -67,7 +67,7 @@ func resourceGiteaLabelCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGiteaLabelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	log.Printf("[DEBUG] Label informations: %s", d.Id())
 	labelId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -86,7 +86,7 @@ func resourceGiteaLabelRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGiteaLabelUpdate(d *schema.ResourceData, meta interface{}) error {
-	// client := meta.(*giteaapi.Client)
+	// client := meta.(*Client)
 	// id := d.Get("id").(int64)
 	// owner := d.Get("owner").(string)
 	// name := d.Get("name").(string)
@@ -104,7 +104,7 @@ func resourceGiteaLabelUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGiteaLabelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
@@ -47,7 +47,7 @@ func resourceGiteaMilestoneSetToState(d *schema.ResourceData, milestone *giteaap
 }
 
 func resourceGiteaMilestoneCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
 	repository := d.Get("repository").(string)
 	options := giteaapi.CreateMilestoneOption{
@@ -67,7 +67,7 @@ func resourceGiteaMilestoneCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceGiteaMilestoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	log.Printf("[DEBUG] milestone informations: %s", d.Id())
 	milestoneId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -86,7 +86,7 @@ func resourceGiteaMilestoneRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGiteaMilestoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	// client := meta.(*giteaapi.Client)
+	// client := meta.(*Client)
 	// id := d.Get("id").(int64)
 	// owner := d.Get("owner").(string)
 	// name := d.Get("name").(string)
@@ -104,7 +104,7 @@ func resourceGiteaMilestoneUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceGiteaMilestoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
@@ -19,6 +19,7 @@ func resourceGiteaOrganization() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGiteaOrganizationImportState,
 		},
+		CustomizeDiff: customizeAvatarDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -37,12 +38,47 @@ func resourceGiteaOrganization() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			// Gitea ignores an empty email on edit, so once set it can not
+			// be cleared and removing it from the configuration keeps it
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"repo_admin_change_team_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			// max_repo_creation is write-only and needs an admin token as soon
+			// as it differs from -1, the instance default
+			"max_repo_creation": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
 			"visibility": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{"public", "limited", "private"}, false),
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"avatar":      avatarSchema(),
+			"avatar_hash": avatarHashSchema(),
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_destroy_transfer_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"website": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -51,7 +87,7 @@ func resourceGiteaOrganization() *schema.Resource {
 	}
 }
 
-func resourceGiteaOrganizationSetToState(d *schema.ResourceData, org *giteaapi.Organization) error {
+func resourceGiteaOrganizationSetToState(d *schema.ResourceData, org *apiOrganization) error {
 	if err := d.Set("name", org.UserName); err != nil {
 		return err
 	}
@@ -64,39 +100,79 @@ func resourceGiteaOrganizationSetToState(d *schema.ResourceData, org *giteaapi.O
 	if err := d.Set("website", org.Website); err != nil {
 		return err
 	}
+	if err := d.Set("location", org.Location); err != nil {
+		return err
+	}
+	if err := d.Set("visibility", org.Visibility); err != nil {
+		return err
+	}
+	if err := d.Set("email", org.Email); err != nil {
+		return err
+	}
+	if err := d.Set("repo_admin_change_team_access", org.RepoAdminChangeTeamAccess); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceGiteaOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
-	options := giteaapi.CreateOrgOption{
-		UserName:    d.Get("name").(string),
-		FullName:    d.Get("full_name").(string),
-		Description: d.Get("description").(string),
-		Website:     d.Get("website").(string),
-		Location:    d.Get("location").(string),
-		Visibility:  d.Get("visibility").(string),
+	client := meta.(*Client)
+	options := createOrgOption{
+		CreateOrgOption: giteaapi.CreateOrgOption{
+			UserName:    d.Get("name").(string),
+			FullName:    d.Get("full_name").(string),
+			Description: d.Get("description").(string),
+			Website:     d.Get("website").(string),
+			Location:    d.Get("location").(string),
+			Visibility:  d.Get("visibility").(string),
+		},
+		Email:                     d.Get("email").(string),
+		RepoAdminChangeTeamAccess: d.Get("repo_admin_change_team_access").(bool),
+	}
+
+	if d.Get("adopt_existing").(bool) {
+		org, err := client.getOrg(options.UserName)
+		if err == nil {
+			log.Printf("[DEBUG] adopt existing organization %q", options.UserName)
+			d.SetId(fmt.Sprintf("%d", org.ID))
+			return resourceGiteaOrganizationUpdate(d, meta)
+		}
+		if !isNotFoundErr(err) {
+			return fmt.Errorf("unable to retrieve organization %s: %v", options.UserName, err)
+		}
 	}
 
 	log.Printf("[DEBUG] create organisation %q", options.UserName)
 
-	org, err := client.CreateOrg(options)
+	org, err := client.createOrg(options)
 
 	if err != nil {
 		return fmt.Errorf("unable to create organization: %v", err)
 	}
 	log.Printf("[DEBUG] organization created: %v", org)
 	d.SetId(fmt.Sprintf("%d", org.ID))
+
+	if err := resourceGiteaOrganizationSetMaxRepoCreation(d, client); err != nil {
+		return err
+	}
+	if err := applyAvatar(d, client, orgScope(d.Get("name").(string))+"/avatar"); err != nil {
+		return err
+	}
 	return resourceGiteaOrganizationRead(d, meta)
 }
 
 func resourceGiteaOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	name := d.Get("name").(string)
 	log.Printf("[DEBUG] read organization %q %s", d.Id(), name)
-	org, err := client.GetOrg(name)
+	org, err := client.getOrg(name)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] organization %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("unable to retrieve organization %s", name)
+		return fmt.Errorf("unable to retrieve organization %s: %v", name, err)
 	}
 	log.Printf("[DEBUG] organization find: %v", org)
 	return resourceGiteaOrganizationSetToState(d, org)
@@ -104,40 +180,132 @@ func resourceGiteaOrganizationRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceGiteaOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	log.Printf("[DEBUG] update organization %s", d.Id())
 
 	name := d.Get("name").(string)
-	edit := giteaapi.EditOrgOption{
-		FullName:    d.Get("full_name").(string),
-		Description: d.Get("description").(string),
-		Website:     d.Get("website").(string),
-		Location:    d.Get("location").(string),
-		Visibility:  d.Get("visibility").(string),
-	}
-	err := client.EditOrg(name, edit)
+	repoAdminChangeTeamAccess := d.Get("repo_admin_change_team_access").(bool)
+	edit := editOrgOption{
+		EditOrgOption: giteaapi.EditOrgOption{
+			FullName:    d.Get("full_name").(string),
+			Description: d.Get("description").(string),
+			Website:     d.Get("website").(string),
+			Location:    d.Get("location").(string),
+			Visibility:  d.Get("visibility").(string),
+		},
+		Email:                     d.Get("email").(string),
+		RepoAdminChangeTeamAccess: &repoAdminChangeTeamAccess,
+	}
+	_, err := client.editOrg(name, edit)
 	if err != nil {
-		return fmt.Errorf("unable to edit organization: %s", name)
+		return fmt.Errorf("unable to edit organization %s: %v", name, err)
 	}
 
+	if err := resourceGiteaOrganizationSetMaxRepoCreation(d, client); err != nil {
+		return err
+	}
+	if err := applyAvatar(d, client, orgScope(d.Get("name").(string))+"/avatar"); err != nil {
+		return err
+	}
 	return resourceGiteaOrganizationRead(d, meta)
 }
 
+// resourceGiteaOrganizationSetMaxRepoCreation applies max_repo_creation when
+// it changed from its previous value, -1 for new organizations. This goes
+// through the admin API, so tokens of non admins can only leave it at -1.
+// Gitea does not return it, so it is write-only and kept as configured.
+func resourceGiteaOrganizationSetMaxRepoCreation(d *schema.ResourceData, client *Client) error {
+	name := d.Get("name").(string)
+	o, n := d.GetChange("max_repo_creation")
+	old, max := o.(int), n.(int)
+	if d.IsNewResource() {
+		old = -1
+	}
+	if old == max {
+		return nil
+	}
+	log.Printf("[DEBUG] set max repo creation of organization %s to %d", name, max)
+	if err := client.setOrgMaxRepoCreation(name, max); err != nil {
+		return fmt.Errorf("unable to set max_repo_creation of organization %s, which needs an admin token: %v", name, err)
+	}
+	return nil
+}
+
 func resourceGiteaOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	name := d.Get("name").(string)
+	if d.Get("force_destroy").(bool) {
+		if err := resourceGiteaOrganizationEmpty(client, name, d.Get("force_destroy_transfer_owner").(string)); err != nil {
+			return err
+		}
+	}
 	log.Printf("[DEBUG] delete organization: %s", name)
 	return client.DeleteOrg(name)
 }
 
+// resourceGiteaOrganizationEmpty removes everything preventing the deletion
+// of an organization: its repositories are deleted, or transferred to
+// transferOwner when set, and its packages are deleted. Repositories which
+// were not moved, such as transfers pending acceptance, are all reported.
+func resourceGiteaOrganizationEmpty(client *Client, name string, transferOwner string) error {
+	repos, err := client.listOrgRepos(name)
+	if err != nil {
+		return fmt.Errorf("unable to list repositories of organization %s: %v", name, err)
+	}
+	var notMoved []string
+	for _, repo := range repos {
+		if transferOwner != "" {
+			log.Printf("[INFO] force_destroy: transfer repository %s/%s to %s", name, repo.Name, transferOwner)
+			if _, err := client.transferRepo(name, repo.Name, transferOwner, nil); err != nil {
+				log.Printf("[WARN] force_destroy: unable to transfer repository %s/%s to %s: %v", name, repo.Name, transferOwner, err)
+				notMoved = append(notMoved, repo.Name)
+			}
+			continue
+		}
+		log.Printf("[INFO] force_destroy: delete repository %s/%s", name, repo.Name)
+		if err := client.DeleteRepo(name, repo.Name); err != nil {
+			return fmt.Errorf("unable to delete repository %s/%s: %v", name, repo.Name, err)
+		}
+	}
+	if len(notMoved) > 0 {
+		return fmt.Errorf("unable to transfer repositories of organization %s to %s, they were not moved: %s", name, transferOwner, strings.Join(notMoved, ", "))
+	}
+
+	packages, err := client.listPackages(name)
+	if err != nil {
+		return fmt.Errorf("unable to list packages of organization %s: %v", name, err)
+	}
+	for _, pkg := range packages {
+		log.Printf("[INFO] force_destroy: delete %s package %s %s of %s", pkg.Type, pkg.Name, pkg.Version, name)
+		if err := client.deletePackage(name, pkg); err != nil {
+			return fmt.Errorf("unable to delete %s package %s %s of %s: %v", pkg.Type, pkg.Name, pkg.Version, name, err)
+		}
+	}
+	return nil
+}
+
 func resourceGiteaOrganizationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
+	client := meta.(*Client)
+
+	// {id}/{name} is still accepted for compatibility, the id being ignored
+	name := d.Id()
+	if parts := strings.Split(d.Id(), "/"); len(parts) == 2 {
+		name = parts[1]
+	} else if len(parts) > 2 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {name}", d.Id())
+	}
 
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("Invalid import id %q. Expecting {id}/{name}", d.Id())
+	org, err := client.getOrg(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve organization %s: %v", name, err)
+	}
+	d.SetId(fmt.Sprintf("%d", org.ID))
+	if err := resourceGiteaOrganizationSetToState(d, org); err != nil {
+		return nil, err
 	}
-	_ = d.Set("name", parts[1])
-	d.SetId(parts[0])
+	_ = d.Set("adopt_existing", false)
+	_ = d.Set("force_destroy", false)
+	_ = d.Set("max_repo_creation", -1)
 
 	return []*schema.ResourceData{d}, nil
 }
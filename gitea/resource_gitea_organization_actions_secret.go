@@ -0,0 +1,117 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGiteaOrganizationActionsSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaOrganizationActionsSecretCreate,
+		Read:   resourceGiteaOrganizationActionsSecretRead,
+		Update: resourceGiteaOrganizationActionsSecretUpdate,
+		Delete: resourceGiteaOrganizationActionsSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaOrganizationActionsSecretImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": actionsNameSchema(),
+			"data": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGiteaOrganizationActionsSecretCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := strings.ToUpper(d.Get("name").(string))
+
+	log.Printf("[DEBUG] create organization actions secret: %s %s", organization, name)
+	err := client.putActionsSecret(orgScope(organization), name, d.Get("data").(string))
+	if err != nil {
+		return fmt.Errorf("unable to create actions secret %s on %s: %v", name, organization, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", organization, name))
+	return resourceGiteaOrganizationActionsSecretRead(d, meta)
+}
+
+func resourceGiteaOrganizationActionsSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] read organization actions secret: %s %s", organization, name)
+
+	secret, err := client.findActionsSecret(orgScope(organization), name)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] organization %s not found, removing actions secret %s from state", organization, name)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve actions secrets of %s: %v", organization, err)
+	}
+	if secret == nil {
+		log.Printf("[WARN] actions secret %s not found on %s, removing from state", name, organization)
+		d.SetId("")
+		return nil
+	}
+
+	// Gitea never returns the secret value, data is kept from configuration
+	d.Set("name", secret.Name)
+	d.Set("created", secret.Created.String())
+	return nil
+}
+
+func resourceGiteaOrganizationActionsSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] update organization actions secret: %s %s", organization, name)
+	err := client.putActionsSecret(orgScope(organization), name, d.Get("data").(string))
+	if err != nil {
+		return fmt.Errorf("unable to update actions secret %s on %s: %v", name, organization, err)
+	}
+
+	return resourceGiteaOrganizationActionsSecretRead(d, meta)
+}
+
+func resourceGiteaOrganizationActionsSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] delete organization actions secret: %s %s", organization, name)
+	return client.deleteActionsSecret(orgScope(organization), name)
+}
+
+func resourceGiteaOrganizationActionsSecretImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {org}/{name}", d.Id())
+	}
+
+	name := strings.ToUpper(parts[1])
+	d.Set("organization", parts[0])
+	d.Set("name", name)
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], name))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,106 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGiteaOrganizationActionsVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaOrganizationActionsVariableCreate,
+		Read:   resourceGiteaOrganizationActionsVariableRead,
+		Update: resourceGiteaOrganizationActionsVariableUpdate,
+		Delete: resourceGiteaOrganizationActionsVariableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaOrganizationActionsVariableImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": actionsNameSchema(),
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceGiteaOrganizationActionsVariableCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := strings.ToUpper(d.Get("name").(string))
+
+	log.Printf("[DEBUG] create organization actions variable: %s %s", organization, name)
+	err := client.createActionsVariable(orgScope(organization), name, d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("unable to create actions variable %s on %s: %v", name, organization, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", organization, name))
+	return resourceGiteaOrganizationActionsVariableRead(d, meta)
+}
+
+func resourceGiteaOrganizationActionsVariableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] read organization actions variable: %s %s", organization, name)
+
+	variable, err := client.getActionsVariable(orgScope(organization), name)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] actions variable %s not found on %s, removing from state", name, organization)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve actions variable %s on %s: %v", name, organization, err)
+	}
+
+	d.Set("name", variable.Name)
+	d.Set("value", variable.Data)
+	return nil
+}
+
+func resourceGiteaOrganizationActionsVariableUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] update organization actions variable: %s %s", organization, name)
+	err := client.updateActionsVariable(orgScope(organization), name, d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("unable to update actions variable %s on %s: %v", name, organization, err)
+	}
+
+	return resourceGiteaOrganizationActionsVariableRead(d, meta)
+}
+
+func resourceGiteaOrganizationActionsVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	organization := d.Get("organization").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] delete organization actions variable: %s %s", organization, name)
+	return client.deleteActionsVariable(orgScope(organization), name)
+}
+
+func resourceGiteaOrganizationActionsVariableImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {org}/{name}", d.Id())
+	}
+
+	name := strings.ToUpper(parts[1])
+	d.Set("organization", parts[0])
+	d.Set("name", name)
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], name))
+
+	return []*schema.ResourceData{d}, nil
+}
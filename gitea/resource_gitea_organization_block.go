@@ -0,0 +1,104 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGiteaOrganizationBlock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaOrganizationBlockCreate,
+		Read:   resourceGiteaOrganizationBlockRead,
+		Delete: resourceGiteaOrganizationBlockDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaOrganizationBlockImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Gitea does not return the note, it is kept as configured
+			"note": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGiteaOrganizationBlockCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+
+	log.Printf("[DEBUG] block %s from organization %s", username, org)
+	if err := client.blockUser(orgScope(org), username, d.Get("note").(string)); err != nil {
+		return fmt.Errorf("unable to block %s from organization %s: %v", username, org, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", org, username))
+	return resourceGiteaOrganizationBlockRead(d, meta)
+}
+
+func resourceGiteaOrganizationBlockRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+	log.Printf("[DEBUG] read block of %s by organization %s", username, org)
+
+	blocked, err := client.findBlock(orgScope(org), username)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] organization %s not found, removing block of %s from state", org, username)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to list users blocked by organization %s: %v", org, err)
+	}
+	if blocked == nil {
+		log.Printf("[WARN] %s is not blocked by %s, removing from state", username, org)
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceGiteaOrganizationBlockDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+	log.Printf("[DEBUG] unblock %s from organization %s", username, org)
+	return client.unblockUser(orgScope(org), username)
+}
+
+func resourceGiteaOrganizationBlockImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {org}/{username}", d.Id())
+	}
+
+	client := meta.(*Client)
+	blocked, err := client.findBlock(orgScope(parts[0]), parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("unable to list users blocked by organization %s: %v", parts[0], err)
+	}
+	if blocked == nil {
+		return nil, fmt.Errorf("%s is not blocked by organization %s", parts[1], parts[0])
+	}
+
+	d.Set("organization", parts[0])
+	d.Set("username", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,24 @@
+package gitea
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestResourceGiteaOrganizationBlockReadOrganizationGone(t *testing.T) {
+	client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"message": "organization does not exist"})
+	})
+	defer done()
+
+	d := resourceGiteaOrganizationBlock().TestResourceData()
+	d.SetId("acme/spammer")
+	d.Set("organization", "acme")
+	d.Set("username", "spammer")
+	if err := resourceGiteaOrganizationBlockRead(d, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Id() != "" {
+		t.Error("expected the block to be removed from state")
+	}
+}
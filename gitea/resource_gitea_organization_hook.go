@@ -19,32 +19,29 @@ func resourceGiteaOrganizationHook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGiteaOrganizationHookImportState,
 		},
+		CustomizeDiff: validateHookConfiguration,
 		Schema: map[string]*schema.Schema{
 			"organization": &schema.Schema{
 				Type:      schema.TypeString,
 				Required:  true,
 				ForceNew:  true,
 			},
-			"type": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Default:   "gitea",
-			},
+			"type": hookTypeSchema(),
 			"branch_filter": {
 				Type: 		schema.TypeString,
 				Optional:   true,
 			},
 			"config": hookConfigurationSchema(),
+			"authorization_header": hookAuthorizationHeaderSchema(),
+			"slack": hookSlackConfigurationSchema(),
+			"telegram": hookTelegramConfigurationSchema(),
+			"matrix": hookMatrixConfigurationSchema(),
+			"packagist": hookPackagistConfigurationSchema(),
 			"url": {
 				Type:      schema.TypeString,
 				Computed:  true,
 			},
-			"events": {
-				Type:      schema.TypeSet,
-				Required:  true,
-				Elem:      &schema.Schema{Type: schema.TypeString},
-				Set:       schema.HashString,
-			},
+			"events": hookEventsSchema(),
 			"active": {
 				Type:      schema.TypeBool,
 				Optional:  true,
@@ -71,7 +68,7 @@ func resourceGiteaOrganizationHookSetToState(d *schema.ResourceData, hook *gitea
 		}
 	}
 
-	d.Set("config", []interface{}{hook.Config})
+	flattenHookConfig(d, hook.Config)
 
 	return nil
 }
@@ -86,7 +83,7 @@ func resourceGiteaOrganizationHookSetToState(d *schema.ResourceData, hook *gitea
  */
 
 func resourceGiteaOrganizationHookCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	organization := d.Get("organization").(string)
 
 	object, err := resourceGiteaOrganizationHookCreateObject(d)
@@ -94,9 +91,9 @@ func resourceGiteaOrganizationHookCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	log.Printf("[DEBUG] create org hook: %s %s %v", organization, object)
+	log.Printf("[DEBUG] create org hook: %s %v", organization, object)
 
-	hook, err := client.CreateOrgHook(organization, object)
+	hook, err := client.createHook(orgScope(organization), object)
 	if err != nil {
 		return err
 	}
@@ -107,7 +104,7 @@ func resourceGiteaOrganizationHookCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGiteaOrganizationHookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	log.Printf("[DEBUG] org hook informations: %s", d.Id())
 	hookId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -126,7 +123,7 @@ func resourceGiteaOrganizationHookRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGiteaOrganizationHookUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	hookId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
@@ -140,7 +137,7 @@ func resourceGiteaOrganizationHookUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] edit repository hook: %s %q %v", organization, hookId, object)
-	err = client.EditOrgHook(organization, hookId, object)
+	err = client.editHook(orgScope(organization), hookId, object)
 	if err != nil {
 	 	return err
 	}
@@ -149,7 +146,7 @@ func resourceGiteaOrganizationHookUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGiteaOrganizationHookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
@@ -159,7 +156,7 @@ func resourceGiteaOrganizationHookDelete(d *schema.ResourceData, meta interface{
 	return client.DeleteOrgHook(organization, id)
 }
 
-func resourceGiteaOrganizationHookCreateObject(d *schema.ResourceData) (giteaapi.CreateHookOption, error) {
+func resourceGiteaOrganizationHookCreateObject(d *schema.ResourceData) (createHookOption, error) {
 	hookType := d.Get("type").(string)
 	branchFilter := d.Get("branch_filter").(string)
 	active := d.Get("active").(bool)
@@ -170,28 +167,23 @@ func resourceGiteaOrganizationHookCreateObject(d *schema.ResourceData) (giteaapi
 		events = append(events, v.(string))
 	}
 
-	config := map[string]string{}
-	configList := d.Get("config").([]interface{})
-	for key, value := range configList[0].(map[string]interface{}) {
-		strKey := fmt.Sprintf("%v", key)
-		strValue := fmt.Sprintf("%v", value)
-		if strValue != "" {
-			config[strKey] = strValue
-		}
-	}
+	config := expandHookConfig(d)
 
-	hook := giteaapi.CreateHookOption{
-		Type: hookType,
-		BranchFilter: branchFilter,
-		Events: events,
-		Config: config,
-		Active: active,
+	hook := createHookOption{
+		CreateHookOption: giteaapi.CreateHookOption{
+			Type: hookType,
+			BranchFilter: branchFilter,
+			Events: events,
+			Config: config,
+			Active: active,
+		},
+		AuthorizationHeader: d.Get("authorization_header").(string),
 	}
 
 	return hook, nil
 }
 
-func resourceGiteaOrganizationHookUpdateObject(d *schema.ResourceData) (giteaapi.EditHookOption, error) {
+func resourceGiteaOrganizationHookUpdateObject(d *schema.ResourceData) (editHookOption, error) {
 	branchFilter := d.Get("branch_filter").(string)
 	active := d.Get("active").(bool)
 	var events []string
@@ -201,21 +193,16 @@ func resourceGiteaOrganizationHookUpdateObject(d *schema.ResourceData) (giteaapi
 		events = append(events, v.(string))
 	}
 
-	config := map[string]string{}
-	configList := d.Get("config").([]interface{})
-	for key, value := range configList[0].(map[string]interface{}) {
-		strKey := fmt.Sprintf("%v", key)
-		strValue := fmt.Sprintf("%v", value)
-		if strValue != "" {
-			config[strKey] = strValue
-		}
-	}
+	config := expandHookConfig(d)
 
-	hook := giteaapi.EditHookOption{
-		BranchFilter: branchFilter,
-		Events: events,
-		Config: config,
-		Active: &active,
+	hook := editHookOption{
+		EditHookOption: giteaapi.EditHookOption{
+			BranchFilter: branchFilter,
+			Events: events,
+			Config: config,
+			Active: &active,
+		},
+		AuthorizationHeader: d.Get("authorization_header").(string),
 	}
 
 	return hook, nil
@@ -228,7 +215,7 @@ func resourceGiteaOrganizationHookImportState(d *schema.ResourceData, meta inter
 		return nil, fmt.Errorf("Invalid import id %q. Expecting {org}/{id}", d.Id())
 	}
 
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	org := parts[0]
 	hookId, err := strconv.ParseInt(parts[1], 10, 64)
 	if err != nil {
@@ -238,7 +225,7 @@ func resourceGiteaOrganizationHookImportState(d *schema.ResourceData, meta inter
 	hook, err := client.GetOrgHook(org, hookId)
 
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve organization hook %s %s %d", org, hook, hookId)
+		return nil, fmt.Errorf("unable to retrieve organization hook %s %d", org, hookId)
 	}
 
 	d.Set("organization", org)
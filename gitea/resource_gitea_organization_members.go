@@ -0,0 +1,129 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceGiteaOrganizationMembers authoritatively manages who is a member of
+// an organization: members missing from the set are removed on apply.
+func resourceGiteaOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaOrganizationMembersCreate,
+		Read:   resourceGiteaOrganizationMembersRead,
+		Update: resourceGiteaOrganizationMembersUpdate,
+		Delete: resourceGiteaOrganizationMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaOrganizationMembersImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// new members join the organization through this team
+			"team": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceGiteaOrganizationMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("organization").(string))
+	return resourceGiteaOrganizationMembersUpdate(d, meta)
+}
+
+func resourceGiteaOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	log.Printf("[DEBUG] read members of organization %s", org)
+
+	members, err := client.listOrgMembers(org)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] organization %s not found, removing from state", org)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to list members of organization %s: %v", org, err)
+	}
+
+	// usernames are case insensitive, the configured spelling is kept so the
+	// set does not differ from the configuration
+	configured := map[string]string{}
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		configured[strings.ToLower(v.(string))] = v.(string)
+	}
+	usernames := []string{}
+	for _, member := range members {
+		if username, ok := configured[strings.ToLower(member.UserName)]; ok {
+			usernames = append(usernames, username)
+			continue
+		}
+		usernames = append(usernames, member.UserName)
+	}
+	d.Set("members", usernames)
+	return nil
+}
+
+func resourceGiteaOrganizationMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	team := d.Get("team").(string)
+
+	current, err := client.listOrgMembers(org)
+	if err != nil {
+		return fmt.Errorf("unable to list members of organization %s: %v", org, err)
+	}
+	existing := map[string]bool{}
+	for _, member := range current {
+		existing[strings.ToLower(member.UserName)] = true
+	}
+	wanted := map[string]bool{}
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		username := v.(string)
+		wanted[strings.ToLower(username)] = true
+		if existing[strings.ToLower(username)] {
+			continue
+		}
+		log.Printf("[DEBUG] add %s to organization %s through team %s", username, org, team)
+		if err := client.addOrgMember(org, team, username); err != nil {
+			return fmt.Errorf("unable to add %s to organization %s: %v", username, org, err)
+		}
+	}
+	for _, member := range current {
+		if wanted[strings.ToLower(member.UserName)] {
+			continue
+		}
+		log.Printf("[INFO] remove %s from organization %s", member.UserName, org)
+		if err := client.DeleteOrgMembership(org, member.UserName); err != nil {
+			return fmt.Errorf("unable to remove %s from organization %s: %v", member.UserName, org, err)
+		}
+	}
+
+	return resourceGiteaOrganizationMembersRead(d, meta)
+}
+
+// resourceGiteaOrganizationMembersDelete only forgets the members, removing
+// everyone would leave the organization without owners.
+func resourceGiteaOrganizationMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] forget members of organization %s", d.Id())
+	return nil
+}
+
+func resourceGiteaOrganizationMembersImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("organization", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,33 @@
+package gitea
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestResourceGiteaOrganizationMembersReadKeepsConfiguredSpelling(t *testing.T) {
+	client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/orgs/acme/members" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		writeJSON(w, http.StatusOK, []map[string]interface{}{{"login": "johndoe"}, {"login": "Other"}})
+	})
+	defer done()
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaOrganizationMembers().Schema, map[string]interface{}{
+		"organization": "acme",
+		"team":         "Owners",
+		"members":      []interface{}{"JohnDoe"},
+	})
+	d.SetId("acme")
+	if err := resourceGiteaOrganizationMembersRead(d, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	members := d.Get("members").(*schema.Set)
+	if members.Len() != 2 || !members.Contains("JohnDoe") || !members.Contains("Other") {
+		t.Errorf("expected JohnDoe and Other, got %v", members.List())
+	}
+}
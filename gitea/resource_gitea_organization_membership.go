@@ -0,0 +1,238 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceGiteaOrganizationMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaOrganizationMembershipCreate,
+		Read:   resourceGiteaOrganizationMembershipRead,
+		Update: resourceGiteaOrganizationMembershipUpdate,
+		Delete: resourceGiteaOrganizationMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaOrganizationMembershipImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "member",
+				ValidateFunc: validation.StringInSlice([]string{"owner", "member"}, false),
+			},
+			// Gitea only knows members through teams, members join the
+			// organization through this team
+			"team": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+			},
+		},
+	}
+}
+
+// resourceGiteaOrganizationMembershipTeam returns the team giving the
+// configured role
+func resourceGiteaOrganizationMembershipTeam(role, team string) (string, error) {
+	if role == "owner" {
+		return orgOwnersTeam, nil
+	}
+	if team == "" {
+		return "", fmt.Errorf("team is required when role is \"member\"")
+	}
+	return team, nil
+}
+
+func resourceGiteaOrganizationMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+
+	team, err := resourceGiteaOrganizationMembershipTeam(d.Get("role").(string), d.Get("team").(string))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] add %s to organization %s through team %s", username, org, team)
+	if err := client.addOrgMember(org, team, username); err != nil {
+		return fmt.Errorf("unable to add %s to organization %s: %v", username, org, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", org, username))
+
+	if _, ok := d.GetOk("visibility"); ok {
+		if err := resourceGiteaOrganizationMembershipSetVisibility(d, client); err != nil {
+			return err
+		}
+	}
+	return resourceGiteaOrganizationMembershipRead(d, meta)
+}
+
+func resourceGiteaOrganizationMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+	log.Printf("[DEBUG] read membership of %s in organization %s", username, org)
+
+	member, err := client.CheckOrgMembership(org, username)
+	if err != nil {
+		return fmt.Errorf("unable to check membership of %s in %s: %v", username, org, err)
+	}
+	if !member {
+		log.Printf("[WARN] %s is not a member of %s, removing from state", username, org)
+		d.SetId("")
+		return nil
+	}
+
+	owners, err := client.findOrgTeam(org, orgOwnersTeam)
+	if err != nil {
+		return fmt.Errorf("unable to list teams of organization %s: %v", org, err)
+	}
+	role := "member"
+	if owners != nil {
+		isOwner, err := client.isTeamMember(owners.ID, username)
+		if err != nil {
+			return fmt.Errorf("unable to check owners of organization %s: %v", org, err)
+		}
+		if isOwner {
+			role = "owner"
+		}
+	}
+	d.Set("role", role)
+
+	if team := d.Get("team").(string); role == "member" && team != "" {
+		t, err := client.findOrgTeam(org, team)
+		if err != nil {
+			return fmt.Errorf("unable to list teams of organization %s: %v", org, err)
+		}
+		inTeam := false
+		if t != nil {
+			if inTeam, err = client.isTeamMember(t.ID, username); err != nil {
+				return fmt.Errorf("unable to check members of team %s: %v", team, err)
+			}
+		}
+		if !inTeam {
+			d.Set("team", "")
+		}
+	}
+
+	public, err := client.CheckPublicOrgMembership(org, username)
+	if err != nil {
+		return fmt.Errorf("unable to check public membership of %s in %s: %v", username, org, err)
+	}
+	if public {
+		d.Set("visibility", "public")
+	} else {
+		d.Set("visibility", "private")
+	}
+	return nil
+}
+
+func resourceGiteaOrganizationMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+
+	if d.HasChange("role") || d.HasChange("team") {
+		oldRole, newRole := d.GetChange("role")
+		oldTeamName, newTeamName := d.GetChange("team")
+		newTeam, err := resourceGiteaOrganizationMembershipTeam(newRole.(string), newTeamName.(string))
+		if err != nil {
+			return err
+		}
+		oldTeam, _ := resourceGiteaOrganizationMembershipTeam(oldRole.(string), oldTeamName.(string))
+
+		if !strings.EqualFold(oldTeam, newTeam) {
+			// join the new team first so that the user never leaves the
+			// organization in between
+			log.Printf("[DEBUG] move %s from team %s to %s in organization %s", username, oldTeam, newTeam, org)
+			if err := client.addOrgMember(org, newTeam, username); err != nil {
+				return fmt.Errorf("unable to add %s to team %s: %v", username, newTeam, err)
+			}
+			if oldTeam != "" {
+				team, err := client.findOrgTeam(org, oldTeam)
+				if err != nil {
+					return fmt.Errorf("unable to list teams of organization %s: %v", org, err)
+				}
+				if team != nil {
+					if err := client.RemoveTeamMember(team.ID, username); err != nil {
+						return fmt.Errorf("unable to remove %s from team %s: %v", username, oldTeam, err)
+					}
+				}
+			}
+		}
+	}
+
+	if d.HasChange("visibility") {
+		if err := resourceGiteaOrganizationMembershipSetVisibility(d, client); err != nil {
+			return err
+		}
+	}
+	return resourceGiteaOrganizationMembershipRead(d, meta)
+}
+
+// resourceGiteaOrganizationMembershipSetVisibility publicizes or conceals the
+// membership. Gitea only lets users change their own, so admins act on behalf
+// of other users.
+func resourceGiteaOrganizationMembershipSetVisibility(d *schema.ResourceData, client *Client) error {
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+	me, err := client.GetMyUserInfo()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the user of the token: %v", err)
+	}
+	path := fmt.Sprintf("%s/public_members/%s", orgScope(org), url.PathEscape(username))
+	if !strings.EqualFold(me.UserName, username) {
+		path += "?sudo=" + url.QueryEscape(username)
+	}
+	method := "DELETE"
+	if d.Get("visibility").(string) == "public" {
+		method = "PUT"
+	}
+	log.Printf("[DEBUG] set visibility of %s membership in %s: %s %s", username, org, method, path)
+	if err := client.apiRequest(method, path, nil, nil); err != nil {
+		return fmt.Errorf("unable to set visibility of %s membership in %s: %v", username, org, err)
+	}
+	return nil
+}
+
+func resourceGiteaOrganizationMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	org := d.Get("organization").(string)
+	username := d.Get("username").(string)
+	log.Printf("[DEBUG] remove %s from organization %s", username, org)
+	return client.DeleteOrgMembership(org, username)
+}
+
+func resourceGiteaOrganizationMembershipImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {org}/{username}", d.Id())
+	}
+
+	d.Set("organization", parts[0])
+	d.Set("username", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
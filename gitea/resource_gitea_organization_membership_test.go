@@ -0,0 +1,78 @@
+package gitea
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestResourceGiteaOrganizationMembershipSetVisibility(t *testing.T) {
+	cases := []struct {
+		name       string
+		visibility string
+		method     string
+		sudo       string
+	}{
+		{name: "public", visibility: "public", method: "PUT", sudo: "johndoe"},
+		{name: "private", visibility: "private", method: "DELETE", sudo: "johndoe"},
+	}
+
+	for _, c := range cases {
+		called := false
+		client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v1/user":
+				writeJSON(w, http.StatusOK, map[string]interface{}{"login": "admin"})
+			case "/api/v1/orgs/acme/public_members/johndoe":
+				called = true
+				if r.Method != c.method || r.URL.Query().Get("sudo") != c.sudo {
+					t.Errorf("%s: unexpected request %s %s", c.name, r.Method, r.URL)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("%s: unexpected request %s %s", c.name, r.Method, r.URL.Path)
+			}
+		})
+
+		d := schema.TestResourceDataRaw(t, resourceGiteaOrganizationMembership().Schema, map[string]interface{}{
+			"organization": "acme",
+			"username":     "johndoe",
+			"visibility":   c.visibility,
+		})
+		err := resourceGiteaOrganizationMembershipSetVisibility(d, client)
+		done()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if !called {
+			t.Errorf("%s: visibility was not set", c.name)
+		}
+	}
+}
+
+func TestResourceGiteaOrganizationMembershipSetOwnVisibility(t *testing.T) {
+	client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/user":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"login": "JohnDoe"})
+		case "/api/v1/orgs/acme/public_members/johndoe":
+			if r.URL.Query().Get("sudo") != "" {
+				t.Errorf("unexpected sudo for the token owner: %s", r.URL)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer done()
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaOrganizationMembership().Schema, map[string]interface{}{
+		"organization": "acme",
+		"username":     "johndoe",
+		"visibility":   "public",
+	})
+	if err := resourceGiteaOrganizationMembershipSetVisibility(d, client); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package gitea
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestResourceGiteaOrganizationEmptyReportsPendingTransfers(t *testing.T) {
+	transferred := []string{}
+	client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/orgs/acme/repos":
+			writeJSON(w, http.StatusOK, []map[string]interface{}{{"name": "alpha"}, {"name": "beta"}})
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/transfer"):
+			name := strings.Split(r.URL.Path, "/")[5]
+			transferred = append(transferred, name)
+			owner := "target"
+			if name == "beta" {
+				owner = "acme"
+			}
+			writeJSON(w, http.StatusCreated, map[string]interface{}{
+				"name":  name,
+				"owner": map[string]interface{}{"login": owner},
+			})
+		case r.Method == "GET" && r.URL.Path == "/api/v1/packages/acme":
+			writeJSON(w, http.StatusOK, []interface{}{})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer done()
+
+	err := resourceGiteaOrganizationEmpty(client, "acme", "target")
+	if err == nil {
+		t.Fatal("expected an error reporting the pending transfer")
+	}
+	if !strings.HasSuffix(err.Error(), ": beta") {
+		t.Errorf("expected only the pending repository to be reported, got %v", err)
+	}
+	if len(transferred) != 2 {
+		t.Errorf("expected every repository to be transferred, got %v", transferred)
+	}
+}
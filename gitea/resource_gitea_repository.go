@@ -3,12 +3,22 @@ package gitea
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// repositoryCreationModes are the API paths a repository can be created
+// through, auto picks one from the owner and the permissions of the token.
+var repositoryCreationModes = []string{"auto", "user", "organization", "admin"}
+
+var repositoryTopicRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-.]{0,34}$`)
+
 type EditRepoOptionHelper struct {
 	Name string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
@@ -34,12 +44,30 @@ func resourceGiteaRepository() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGiteaRepositoryImportState,
 		},
+		CustomizeDiff: resourceGiteaRepositoryCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"owner": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"transfer_team_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"creation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "auto",
+				ValidateFunc: validation.StringInSlice(repositoryCreationModes, false),
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -133,6 +161,191 @@ func resourceGiteaRepository() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"has_projects": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"has_releases": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"has_packages": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"has_actions": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"external_tracker": &schema.Schema{
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"style": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "numeric",
+							ValidateFunc: validation.StringInSlice([]string{"numeric", "alphanumeric", "regexp"}, false),
+						},
+						"regexp_pattern": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"external_wiki": &schema.Schema{
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"enable_time_tracker": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"allow_only_contributors_to_track_time": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"enable_issue_dependencies": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"default_merge_style": &schema.Schema{
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"merge", "rebase", "rebase-merge", "squash", "fast-forward-only"}, false),
+			},
+			"default_delete_branch_after_merge": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			// Gitea does not return the manual merge settings, they are
+			// kept as configured
+			"allow_manual_merge": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"autodetect_manual_merge": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"allow_rebase_update": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			// Gitea returns the interval as a Go duration, e.g. 8h0m0s for 8h
+			"mirror_interval": &schema.Schema{
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: suppressEquivalentDuration,
+			},
+			"archive_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"prevent_destroy_if_not_empty": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"avatar":      avatarSchema(),
+			"avatar_hash": avatarHashSchema(),
+			// topics are authoritative: leaving them out clears them, including
+			// the ones copied from a template or found on an adopted repository
+			"topics": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(repositoryTopicRegexp, "must start with a lowercase letter or number, contain only lowercase letters, numbers, dashes and dots and be at most 35 characters long"),
+				},
+				Set: schema.HashString,
+			},
+			"template": &schema.Schema{
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"git_content": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"git_hooks": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"topics": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"webhooks": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"labels": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"avatar": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"is_template": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
 			"fork": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -201,7 +414,7 @@ func resourceGiteaRepository() *schema.Resource {
 	}
 }
 
-func resourceGiteaRepositorySetToState(d *schema.ResourceData, repo *giteaapi.Repository) {
+func resourceGiteaRepositorySetToState(d *schema.ResourceData, repo *apiRepository) {
 	d.SetId(fmt.Sprintf("%d", repo.ID))
 	d.Set("owner", repo.Owner.UserName)
 	d.Set("name", repo.Name)
@@ -219,6 +432,37 @@ func resourceGiteaRepositorySetToState(d *schema.ResourceData, repo *giteaapi.Re
 	d.Set("allow_rebase_merge", repo.AllowRebaseMerge)
 	d.Set("allow_squash", repo.AllowSquash)
 	d.Set("archived", repo.Archived)
+	d.Set("is_template", repo.Template)
+	d.Set("has_projects", repo.HasProjects)
+	d.Set("has_releases", repo.HasReleases)
+	d.Set("has_packages", repo.HasPackages)
+	d.Set("has_actions", repo.HasActions)
+	if repo.InternalTracker != nil {
+		d.Set("enable_time_tracker", repo.InternalTracker.EnableTimeTracker)
+		d.Set("allow_only_contributors_to_track_time", repo.InternalTracker.AllowOnlyContributorsToTrackTime)
+		d.Set("enable_issue_dependencies", repo.InternalTracker.EnableIssueDependencies)
+	}
+	if repo.ExternalTracker != nil {
+		d.Set("external_tracker", []interface{}{map[string]interface{}{
+			"url":            repo.ExternalTracker.ExternalTrackerURL,
+			"format":         repo.ExternalTracker.ExternalTrackerFormat,
+			"style":          repo.ExternalTracker.ExternalTrackerStyle,
+			"regexp_pattern": repo.ExternalTracker.ExternalTrackerRegexpPattern,
+		}})
+	} else {
+		d.Set("external_tracker", nil)
+	}
+	if repo.ExternalWiki != nil {
+		d.Set("external_wiki", []interface{}{map[string]interface{}{
+			"url": repo.ExternalWiki.ExternalWikiURL,
+		}})
+	} else {
+		d.Set("external_wiki", nil)
+	}
+	d.Set("default_merge_style", repo.DefaultMergeStyle)
+	d.Set("default_delete_branch_after_merge", repo.DefaultDeleteBranchAfterMerge)
+	d.Set("allow_rebase_update", repo.AllowRebaseUpdate)
+	d.Set("mirror_interval", repo.MirrorInterval)
 	d.Set("fork", repo.Fork)
 	d.Set("empty", repo.Empty)
 	d.Set("mirror", repo.Mirror)
@@ -237,7 +481,7 @@ func resourceGiteaRepositorySetToState(d *schema.ResourceData, repo *giteaapi.Re
 	d.Set("permission_pull", repo.Permissions.Pull)
 }
 
-func resourceGiteaRepositoryEditOptions(d *schema.ResourceData) giteaapi.EditRepoOption {
+func resourceGiteaRepositoryEditOptions(d *schema.ResourceData) editRepoOption {
 	edit := EditRepoOptionHelper{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
@@ -255,27 +499,184 @@ func resourceGiteaRepositoryEditOptions(d *schema.ResourceData) giteaapi.EditRep
 		Archived: d.Get("archived").(bool),
 	}
 
-	return giteaapi.EditRepoOption{
-		Name:        &edit.Name,
-		Description: &edit.Description,
-		Private:     &edit.Private,
-		Website:	 &edit.Website,
-		HasIssues:   &edit.HasIssues,
-		HasWiki:     &edit.HasWiki,
-		DefaultBranch: &edit.DefaultBranch,
-		HasPullRequests: &edit.HasPullRequests,
-		IgnoreWhitespaceConflicts:  &edit.IgnoreWhitespaceConflicts,
-		AllowMerge:  &edit.AllowMerge,
-		AllowRebase:  &edit.AllowRebase,
-		AllowRebaseMerge:  &edit.AllowRebaseMerge,
-		AllowSquash:  &edit.AllowSquash,
-		Archived:  &edit.Archived,
+	isTemplate := d.Get("is_template").(bool)
+
+	option := editRepoOption{
+		EditRepoOption: giteaapi.EditRepoOption{
+			Name:        &edit.Name,
+			Description: &edit.Description,
+			Private:     &edit.Private,
+			Website:	 &edit.Website,
+			HasIssues:   &edit.HasIssues,
+			HasWiki:     &edit.HasWiki,
+			DefaultBranch: &edit.DefaultBranch,
+			HasPullRequests: &edit.HasPullRequests,
+			IgnoreWhitespaceConflicts:  &edit.IgnoreWhitespaceConflicts,
+			AllowMerge:  &edit.AllowMerge,
+			AllowRebase:  &edit.AllowRebase,
+			AllowRebaseMerge:  &edit.AllowRebaseMerge,
+			AllowSquash:  &edit.AllowSquash,
+			Archived:  &edit.Archived,
+		},
+		Template:                      &isTemplate,
+		HasProjects:                   optionalBool(d, "has_projects"),
+		HasReleases:                   optionalBool(d, "has_releases"),
+		HasPackages:                   optionalBool(d, "has_packages"),
+		HasActions:                    optionalBool(d, "has_actions"),
+		DefaultMergeStyle:             optionalString(d, "default_merge_style"),
+		DefaultDeleteBranchAfterMerge: optionalBool(d, "default_delete_branch_after_merge"),
+		AllowManualMerge:              optionalBool(d, "allow_manual_merge"),
+		AutodetectManualMerge:         optionalBool(d, "autodetect_manual_merge"),
+		AllowRebaseUpdate:             optionalBool(d, "allow_rebase_update"),
+	}
+
+	if trackers := d.Get("external_tracker").([]interface{}); len(trackers) > 0 && trackers[0] != nil {
+		tracker := trackers[0].(map[string]interface{})
+		option.ExternalTracker = &repositoryExternalTracker{
+			ExternalTrackerURL:           tracker["url"].(string),
+			ExternalTrackerFormat:        tracker["format"].(string),
+			ExternalTrackerStyle:         tracker["style"].(string),
+			ExternalTrackerRegexpPattern: tracker["regexp_pattern"].(string),
+		}
+	} else if d.Get("has_issues").(bool) && resourceGiteaRepositoryHasInternalTracker(d) {
+		option.InternalTracker = &repositoryInternalTracker{
+			EnableTimeTracker:                d.Get("enable_time_tracker").(bool),
+			AllowOnlyContributorsToTrackTime: d.Get("allow_only_contributors_to_track_time").(bool),
+			EnableIssueDependencies:          d.Get("enable_issue_dependencies").(bool),
+		}
+	}
+	if wikis := d.Get("external_wiki").([]interface{}); len(wikis) > 0 && wikis[0] != nil {
+		option.ExternalWiki = &repositoryExternalWiki{
+			ExternalWikiURL: wikis[0].(map[string]interface{})["url"].(string),
+		}
+	}
+	// Gitea refuses a mirror interval for repositories which are not mirrors
+	if d.Get("mirror").(bool) {
+		option.MirrorInterval = optionalString(d, "mirror_interval")
+	}
+	return option
+}
+
+// resourceGiteaRepositoryHasInternalTracker reports whether any setting of the
+// built-in issue tracker is configured or known from state, otherwise Gitea
+// defaults are kept.
+func resourceGiteaRepositoryHasInternalTracker(d *schema.ResourceData) bool {
+	for _, key := range []string{"enable_time_tracker", "allow_only_contributors_to_track_time", "enable_issue_dependencies"} {
+		if _, ok := d.GetOkExists(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// optionalBool returns a pointer to the value of key, or nil when it is
+// neither configured nor known from state, so that Gitea keeps its default.
+func optionalBool(d *schema.ResourceData, key string) *bool {
+	if v, ok := d.GetOkExists(key); ok {
+		b := v.(bool)
+		return &b
 	}
+	return nil
 }
 
+// optionalString is optionalBool for strings, empty strings are not sent.
+func optionalString(d *schema.ResourceData, key string) *string {
+	if v, ok := d.GetOk(key); ok {
+		str := v.(string)
+		return &str
+	}
+	return nil
+}
+
+// validateDuration checks that the value is a Go duration such as 8h or 1h30m
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 8h or 1h30m: %v", k, err))
+	}
+	return
+}
+
+// suppressEquivalentDuration hides the difference between two spellings of
+// the same duration
+func suppressEquivalentDuration(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	n, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+// resourceGiteaRepositoryCustomizeDiff rejects replacing a repository that
+// archive_on_destroy would only archive: the old repository keeps its name and
+// creating the new one would fail. It must be renamed by hand first.
+func resourceGiteaRepositoryCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && (d.HasChange("template") || d.HasChange("issue_labels")) {
+		if archive, _ := d.GetChange("archive_on_destroy"); archive.(bool) {
+			return fmt.Errorf("changing template or issue_labels replaces the repository, which archive_on_destroy only archives: rename or delete the repository by hand, or unset archive_on_destroy first")
+		}
+	}
+	return customizeAvatarDiff(d, meta)
+}
+
+// resourceGiteaRepositoryCreateRepo creates the repository through the path
+// selected by mode: the token owner's repositories, an organization's or, for
+// any other owner, the admin API.
+func resourceGiteaRepositoryCreateRepo(client *Client, owner, mode string, options giteaapi.CreateRepoOption) (*giteaapi.Repository, error) {
+	if mode == "auto" {
+		me, err := client.GetMyUserInfo()
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve the user of the token: %v", err)
+		}
+		switch {
+		case strings.EqualFold(me.UserName, owner):
+			mode = "user"
+		case me.IsAdmin:
+			mode = "admin"
+		default:
+			_, err := client.GetOrg(owner)
+			if isNotFoundErr(err) {
+				return nil, fmt.Errorf("unable to create repository %s/%s: %s is neither the user of the token nor an organization, "+
+					"creating repositories for other users requires a site administrator token", owner, options.Name, owner)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve organization %s: %v", owner, err)
+			}
+			mode = "organization"
+		}
+	}
+
+	log.Printf("[DEBUG] create repository %s/%s as %s", owner, options.Name, mode)
+
+	var repository *giteaapi.Repository
+	var err error
+	switch mode {
+	case "user":
+		repository, err = client.CreateRepo(options)
+	case "organization":
+		repository, err = client.CreateOrgRepo(owner, options)
+	default:
+		repository, err = client.AdminCreateRepo(owner, options)
+	}
+	if isForbiddenErr(err) {
+		switch mode {
+		case "organization":
+			return nil, fmt.Errorf("unable to create repository %s/%s: the user of the token is not allowed to create repositories in organization %s", owner, options.Name, owner)
+		case "admin":
+			return nil, fmt.Errorf("unable to create repository %s/%s: creating repositories for another owner requires a site administrator token", owner, options.Name)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create repository %s/%s: %v", owner, options.Name, err)
+	}
+	return repository, nil
+}
 
 func resourceGiteaRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
 	// need to manage partial state as some properties can only be set on edit
 	d.Partial(true)
@@ -289,75 +690,233 @@ func resourceGiteaRepositoryCreate(d *schema.ResourceData, meta interface{}) err
 		Readme:      d.Get("readme").(string),
 	}
 
-	log.Printf("[DEBUG] create repository %s", options.Name)
+	if d.Get("adopt_existing").(bool) {
+		repository, err := client.getRepo(owner, options.Name)
+		if err == nil {
+			return resourceGiteaRepositoryAdopt(d, meta, repository)
+		}
+		if !isNotFoundErr(err) {
+			return fmt.Errorf("unable to retrieve repository %s/%s: %v", owner, options.Name, err)
+		}
+	}
 
-	repository, err := client.AdminCreateRepo(owner, options)
-	if err != nil {
-		return err
+	var repositoryID int64
+	if templates := d.Get("template").([]interface{}); len(templates) > 0 && templates[0] != nil {
+		template := templates[0].(map[string]interface{})
+		templateOwner := template["owner"].(string)
+		templateName := template["name"].(string)
+		generate := generateRepoOption{
+			Owner:       owner,
+			Name:        options.Name,
+			Description: options.Description,
+			Private:     options.Private,
+			GitContent:  template["git_content"].(bool),
+			GitHooks:    template["git_hooks"].(bool),
+			Topics:      template["topics"].(bool),
+			Webhooks:    template["webhooks"].(bool),
+			Labels:      template["labels"].(bool),
+			Avatar:      template["avatar"].(bool),
+		}
+
+		log.Printf("[DEBUG] create repository %s from template %s/%s", options.Name, templateOwner, templateName)
+
+		repository, err := client.generateRepo(templateOwner, templateName, generate)
+		if err != nil {
+			return fmt.Errorf("unable to create repository %s from template %s/%s: %v", options.Name, templateOwner, templateName, err)
+		}
+		log.Printf("[DEBUG] Repository generated (partial): %v", repository)
+		repositoryID = repository.ID
+	} else {
+		log.Printf("[DEBUG] create repository %s", options.Name)
+
+		repository, err := resourceGiteaRepositoryCreateRepo(client, owner, d.Get("creation_mode").(string), options)
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Repository created (partial): %v", repository)
+		repositoryID = repository.ID
 	}
-	log.Printf("[DEBUG] Repository created (partial): %v", repository)
-	d.SetId(fmt.Sprintf("%d", repository.ID))
+	d.SetId(fmt.Sprintf("%d", repositoryID))
 
 	log.Printf("[DEBUG] update repository %s", d.Id())
 	edit := resourceGiteaRepositoryEditOptions(d)
-	_, err = client.EditRepo(owner, options.Name, edit)
+	repository, err := client.editRepo(owner, options.Name, edit)
 	if err != nil {
 		return err
 	}
 
+	if _, ok := d.GetOk("topics"); ok {
+		if err := resourceGiteaRepositorySetTopics(d, client, owner, options.Name); err != nil {
+			return err
+		}
+	}
+	if err := applyAvatar(d, client, repoScope(owner, options.Name)+"/avatar"); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Repository finalized: %v", repository)
 	// Everything complete
 	d.Partial(false)
 	return resourceGiteaRepositoryRead(d, meta)
 }
 
+// resourceGiteaRepositoryAdopt takes over an existing repository instead of
+// creating it and applies the configured settings to it
+func resourceGiteaRepositoryAdopt(d *schema.ResourceData, meta interface{}, repository *apiRepository) error {
+	client := meta.(*Client)
+	owner := repository.Owner.UserName
+	name := repository.Name
+	log.Printf("[DEBUG] adopt existing repository %s/%s", owner, name)
+	d.SetId(fmt.Sprintf("%d", repository.ID))
+
+	edit := resourceGiteaRepositoryEditOptions(d)
+	if _, err := client.editRepo(owner, name, edit); err != nil {
+		return fmt.Errorf("unable to update repository %s/%s: %v", owner, name, err)
+	}
+	if _, ok := d.GetOk("topics"); ok {
+		if err := resourceGiteaRepositorySetTopics(d, client, owner, name); err != nil {
+			return err
+		}
+	}
+	if err := applyAvatar(d, client, repoScope(owner, name)+"/avatar"); err != nil {
+		return err
+	}
+	d.Partial(false)
+	return resourceGiteaRepositoryRead(d, meta)
+}
+
 func resourceGiteaRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
 	name := d.Get("name").(string)
 	log.Printf("[DEBUG] read repository %q %s %s", d.Id(), owner, name)
-	repo, err := client.GetRepo(owner, name)
+
+	// the ID survives renames and transfers made outside of terraform
+	var repo *apiRepository
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err == nil {
+		repo, err = client.getRepoByID(id)
+	} else {
+		repo, err = client.getRepo(owner, name)
+	}
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] repository %s/%s not found, removing from state", owner, name)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("unable to retrieve repository %s %s", owner, name)
+		return fmt.Errorf("unable to retrieve repository %s %s: %v", owner, name, err)
 	}
 	log.Printf("[DEBUG] repository find: %v", repo)
 	resourceGiteaRepositorySetToState(d, repo)
-	return nil
+	return resourceGiteaRepositoryReadTopics(d, client, repo.Owner.UserName, repo.Name)
 }
 
 func resourceGiteaRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
 	name := d.Get("name").(string)
+	o, _ := d.GetChange("owner")
+	oldOwner := o.(string)
+	n, _ := d.GetChange("name")
+	oldName := n.(string)
+
+	// the edit renames the repository, so it addresses it by its old name
+	log.Printf("[DEBUG] update repository %s", d.Id())
+	edit := resourceGiteaRepositoryEditOptions(d)
+	_, err := client.editRepo(oldOwner, oldName, edit)
+	if err != nil {
+		return fmt.Errorf("unable to update repository %s/%s: %v", oldOwner, oldName, err)
+	}
 
 	if d.HasChange("owner") {
 		log.Printf("[DEBUG] change owner of repository %s to %s", d.Id(), owner)
-		o, _ := d.GetChange("owner")
-		old := o.(string)
-		transferOptions := giteaapi.TransferRepoOption{
-			NewOwner: owner,
+		teamIDs := []int64{}
+		for _, v := range d.Get("transfer_team_ids").(*schema.Set).List() {
+			teamIDs = append(teamIDs, int64(v.(int)))
 		}
-		_, err := client.TransferRepo(old, name, transferOptions)
+		_, err := client.transferRepo(oldOwner, name, owner, teamIDs)
 		if err != nil {
-			 return err
+			// the repository stays where it was, which the state must reflect
+			d.Set("owner", oldOwner)
+			return fmt.Errorf("unable to transfer repository %s/%s to %s: %v", oldOwner, name, owner, err)
 		}
 	}
-	log.Printf("[DEBUG] update repository %s", d.Id())
-
-	edit := resourceGiteaRepositoryEditOptions(d)
 
-	_, err := client.EditRepo(owner, name, edit)
-	if err != nil {
+	if d.HasChange("topics") {
+		if err := resourceGiteaRepositorySetTopics(d, client, owner, name); err != nil {
+			return err
+		}
+	}
+	if err := applyAvatar(d, client, repoScope(owner, name)+"/avatar"); err != nil {
 		return err
 	}
 
 	return resourceGiteaRepositoryRead(d, meta)
 }
 
+// resourceGiteaRepositorySetTopics replaces all the topics of the repository at once
+func resourceGiteaRepositorySetTopics(d *schema.ResourceData, client *Client, owner string, name string) error {
+	topics := []string{}
+	for _, v := range d.Get("topics").(*schema.Set).List() {
+		topics = append(topics, v.(string))
+	}
+	log.Printf("[DEBUG] set topics of repository %s/%s: %v", owner, name, topics)
+	if err := client.SetRepoTopics(owner, name, topics); err != nil {
+		return fmt.Errorf("unable to set topics of repository %s/%s: %v", owner, name, err)
+	}
+	return nil
+}
+
+// resourceGiteaRepositoryReadTopics reads the topics back for drift detection
+func resourceGiteaRepositoryReadTopics(d *schema.ResourceData, client *Client, owner string, name string) error {
+	options := giteaapi.ListRepoTopicsOptions{
+		ListOptions: giteaapi.ListOptions{Page: 1, PageSize: 50},
+	}
+	topics, err := client.ListRepoTopics(owner, name, options)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve topics of repository %s/%s: %v", owner, name, err)
+	}
+	return d.Set("topics", topics)
+}
+
 func resourceGiteaRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
 	name := d.Get("name").(string)
+
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("repository %s/%s has deletion_protection enabled, set it to false and apply before destroying it", owner, name)
+	}
+
+	if d.Get("archive_on_destroy").(bool) {
+		log.Printf("[DEBUG] archive repository: %s %s", owner, name)
+		archived := true
+		_, err := client.editRepo(owner, name, editRepoOption{
+			EditRepoOption: giteaapi.EditRepoOption{Archived: &archived},
+		})
+		if isNotFoundErr(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to archive repository %s/%s: %v", owner, name, err)
+		}
+		return nil
+	}
+
+	if d.Get("prevent_destroy_if_not_empty").(bool) {
+		repo, err := client.getRepo(owner, name)
+		if isNotFoundErr(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to retrieve repository %s/%s: %v", owner, name, err)
+		}
+		if !repo.Empty {
+			return fmt.Errorf("repository %s/%s is not empty and prevent_destroy_if_not_empty is enabled", owner, name)
+		}
+	}
+
 	log.Printf("[DEBUG] delete repository: %s %s", owner, name)
 	return client.DeleteRepo(owner, name)
 }
@@ -369,10 +928,10 @@ func resourceGiteaRepositoryImportState(d *schema.ResourceData, meta interface{}
 		return nil, fmt.Errorf("Invalid import id %q. Expecting {owner}/{name}", d.Id())
 	}
 
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := parts[0]
 	name := parts[1]
-	repo, err := client.GetRepo(owner, name)
+	repo, err := client.getRepo(owner, name)
 
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve repository %s %s", owner, name)
@@ -380,6 +939,13 @@ func resourceGiteaRepositoryImportState(d *schema.ResourceData, meta interface{}
 
 	d.SetId(fmt.Sprintf("%d", repo.ID))
 	resourceGiteaRepositorySetToState(d, repo)
-	
+	d.Set("archive_on_destroy", false)
+	d.Set("prevent_destroy_if_not_empty", false)
+	d.Set("deletion_protection", false)
+	d.Set("adopt_existing", false)
+	if err := resourceGiteaRepositoryReadTopics(d, client, owner, name); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
\ No newline at end of file
@@ -0,0 +1,127 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGiteaRepositoryActionsSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaRepositoryActionsSecretCreate,
+		Read:   resourceGiteaRepositoryActionsSecretRead,
+		Update: resourceGiteaRepositoryActionsSecretUpdate,
+		Delete: resourceGiteaRepositoryActionsSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaRepositoryActionsSecretImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": actionsNameSchema(),
+			"data": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGiteaRepositoryActionsSecretCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := strings.ToUpper(d.Get("name").(string))
+
+	log.Printf("[DEBUG] create repository actions secret: %s %s %s", owner, repository, name)
+	err := client.putActionsSecret(repoScope(owner, repository), name, d.Get("data").(string))
+	if err != nil {
+		return fmt.Errorf("unable to create actions secret %s on %s/%s: %v", name, owner, repository, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repository, name))
+	return resourceGiteaRepositoryActionsSecretRead(d, meta)
+}
+
+func resourceGiteaRepositoryActionsSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] read repository actions secret: %s %s %s", owner, repository, name)
+
+	secret, err := client.findActionsSecret(repoScope(owner, repository), name)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] repository %s/%s not found, removing actions secret %s from state", owner, repository, name)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve actions secrets of %s/%s: %v", owner, repository, err)
+	}
+	if secret == nil {
+		log.Printf("[WARN] actions secret %s not found on %s/%s, removing from state", name, owner, repository)
+		d.SetId("")
+		return nil
+	}
+
+	// Gitea never returns the secret value, data is kept from configuration
+	d.Set("name", secret.Name)
+	d.Set("created", secret.Created.String())
+	return nil
+}
+
+func resourceGiteaRepositoryActionsSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] update repository actions secret: %s %s %s", owner, repository, name)
+	err := client.putActionsSecret(repoScope(owner, repository), name, d.Get("data").(string))
+	if err != nil {
+		return fmt.Errorf("unable to update actions secret %s on %s/%s: %v", name, owner, repository, err)
+	}
+
+	return resourceGiteaRepositoryActionsSecretRead(d, meta)
+}
+
+func resourceGiteaRepositoryActionsSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] delete repository actions secret: %s %s %s", owner, repository, name)
+	return client.deleteActionsSecret(repoScope(owner, repository), name)
+}
+
+func resourceGiteaRepositoryActionsSecretImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {owner}/{repo}/{name}", d.Id())
+	}
+
+	name := strings.ToUpper(parts[2])
+	d.Set("owner", parts[0])
+	d.Set("repository", parts[1])
+	d.Set("name", name)
+	d.SetId(fmt.Sprintf("%s/%s/%s", parts[0], parts[1], name))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,116 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGiteaRepositoryActionsVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaRepositoryActionsVariableCreate,
+		Read:   resourceGiteaRepositoryActionsVariableRead,
+		Update: resourceGiteaRepositoryActionsVariableUpdate,
+		Delete: resourceGiteaRepositoryActionsVariableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaRepositoryActionsVariableImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": actionsNameSchema(),
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceGiteaRepositoryActionsVariableCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := strings.ToUpper(d.Get("name").(string))
+
+	log.Printf("[DEBUG] create repository actions variable: %s %s %s", owner, repository, name)
+	err := client.createActionsVariable(repoScope(owner, repository), name, d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("unable to create actions variable %s on %s/%s: %v", name, owner, repository, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repository, name))
+	return resourceGiteaRepositoryActionsVariableRead(d, meta)
+}
+
+func resourceGiteaRepositoryActionsVariableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] read repository actions variable: %s %s %s", owner, repository, name)
+
+	variable, err := client.getActionsVariable(repoScope(owner, repository), name)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] actions variable %s not found on %s/%s, removing from state", name, owner, repository)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve actions variable %s on %s/%s: %v", name, owner, repository, err)
+	}
+
+	d.Set("name", variable.Name)
+	d.Set("value", variable.Data)
+	return nil
+}
+
+func resourceGiteaRepositoryActionsVariableUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] update repository actions variable: %s %s %s", owner, repository, name)
+	err := client.updateActionsVariable(repoScope(owner, repository), name, d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("unable to update actions variable %s on %s/%s: %v", name, owner, repository, err)
+	}
+
+	return resourceGiteaRepositoryActionsVariableRead(d, meta)
+}
+
+func resourceGiteaRepositoryActionsVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] delete repository actions variable: %s %s %s", owner, repository, name)
+	return client.deleteActionsVariable(repoScope(owner, repository), name)
+}
+
+func resourceGiteaRepositoryActionsVariableImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import id %q. Expecting {owner}/{repo}/{name}", d.Id())
+	}
+
+	name := strings.ToUpper(parts[2])
+	d.Set("owner", parts[0])
+	d.Set("repository", parts[1])
+	d.Set("name", name)
+	d.SetId(fmt.Sprintf("%s/%s/%s", parts[0], parts[1], name))
+
+	return []*schema.ResourceData{d}, nil
+}
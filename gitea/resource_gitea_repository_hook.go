@@ -19,6 +19,7 @@ func resourceGiteaRepositoryHook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGiteaRepositoryHookImportState,
 		},
+		CustomizeDiff: validateHookConfiguration,
 		Schema: map[string]*schema.Schema{
 			"owner": &schema.Schema{
 				Type:      schema.TypeString,
@@ -29,31 +30,34 @@ func resourceGiteaRepositoryHook() *schema.Resource {
 				Type:      schema.TypeString,
 				Required:  true,
 			},
-			"type": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Default:   "gitea",
-			},
+			"type": hookTypeSchema(),
 			"branch_filter": {
 				Type: 		schema.TypeString,
 				Optional:   true,
 			},
 			"config": hookConfigurationSchema(),
+			"authorization_header": hookAuthorizationHeaderSchema(),
+			"slack": hookSlackConfigurationSchema(),
+			"telegram": hookTelegramConfigurationSchema(),
+			"matrix": hookMatrixConfigurationSchema(),
+			"packagist": hookPackagistConfigurationSchema(),
 			"url": {
 				Type:      schema.TypeString,
 				Computed:  true,
 			},
-			"events": {
-				Type:      schema.TypeSet,
-				Required:  true,
-				Elem:      &schema.Schema{Type: schema.TypeString},
-				Set:       schema.HashString,
-			},
+			"events": hookEventsSchema(),
 			"active": {
 				Type:      schema.TypeBool,
 				Optional:  true,
 				Default:   true,
 			},
+			// triggers a test delivery after create and update, only the
+			// trigger can fail the apply, not the answer of the receiver
+			"test_on_change": {
+				Type:      schema.TypeBool,
+				Optional:  true,
+				Default:   false,
+			},
 		},
 	}
 }
@@ -75,7 +79,7 @@ func resourceGiteaRepositoryHookSetToState(d *schema.ResourceData, hook *giteaap
 		}
 	}
 
-	d.Set("config", []interface{}{hook.Config})
+	flattenHookConfig(d, hook.Config)
 
 	return nil
 }
@@ -90,7 +94,7 @@ func resourceGiteaRepositoryHookSetToState(d *schema.ResourceData, hook *giteaap
  */
 
 func resourceGiteaRepositoryHookCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := d.Get("owner").(string)
 	repository := d.Get("repository").(string)
 
@@ -101,18 +105,22 @@ func resourceGiteaRepositoryHookCreate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] create repo hook: %s %s %v", owner, repository, object)
 
-	hook, err := client.CreateRepoHook(owner, repository, object)
+	hook, err := client.createHook(repoScope(owner, repository), object)
 	if err != nil {
 		return err
 	}
 	log.Printf("[DEBUG] repo hook created %v", hook)
 	d.SetId(strconv.FormatInt(hook.ID, 10))
 
+	if err := resourceGiteaRepositoryHookTest(d, client, hook.ID); err != nil {
+		return err
+	}
+
 	return resourceGiteaRepositoryHookRead(d, meta)
 }
 
 func resourceGiteaRepositoryHookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	log.Printf("[DEBUG] repo hook informations: %s", d.Id())
 	hookId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -132,7 +140,7 @@ func resourceGiteaRepositoryHookRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGiteaRepositoryHookUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	hookId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
@@ -147,16 +155,20 @@ func resourceGiteaRepositoryHookUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] edit repository hook: %s %s %q %v", owner, repository, hookId, object)
-	err = client.EditRepoHook(owner, repository, hookId, object)
+	err = client.editHook(repoScope(owner, repository), hookId, object)
 	if err != nil {
 	 	return err
 	}
 
+	if err := resourceGiteaRepositoryHookTest(d, client, hookId); err != nil {
+		return err
+	}
+
 	return resourceGiteaRepositoryHookRead(d, meta)
 }
 
 func resourceGiteaRepositoryHookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
@@ -167,7 +179,21 @@ func resourceGiteaRepositoryHookDelete(d *schema.ResourceData, meta interface{})
 	return client.DeleteRepoHook(owner, repository, id)
 }
 
-func resourceGiteaRepositoryHookCreateObject(d *schema.ResourceData) (giteaapi.CreateHookOption, error) {
+// resourceGiteaRepositoryHookTest triggers a test delivery when test_on_change is set
+func resourceGiteaRepositoryHookTest(d *schema.ResourceData, client *Client, hookId int64) error {
+	if !d.Get("test_on_change").(bool) {
+		return nil
+	}
+	owner := d.Get("owner").(string)
+	repository := d.Get("repository").(string)
+	log.Printf("[DEBUG] test repo hook: %s %s %d", owner, repository, hookId)
+	if err := client.testHook(repoScope(owner, repository), hookId); err != nil {
+		return fmt.Errorf("test delivery of repository hook %d failed: %v", hookId, err)
+	}
+	return nil
+}
+
+func resourceGiteaRepositoryHookCreateObject(d *schema.ResourceData) (createHookOption, error) {
 	hookType := d.Get("type").(string)
 	branchFilter := d.Get("branch_filter").(string)
 	active := d.Get("active").(bool)
@@ -178,28 +204,23 @@ func resourceGiteaRepositoryHookCreateObject(d *schema.ResourceData) (giteaapi.C
 		events = append(events, v.(string))
 	}
 
-	config := map[string]string{}
-	configList := d.Get("config").([]interface{})
-	for key, value := range configList[0].(map[string]interface{}) {
-		strKey := fmt.Sprintf("%v", key)
-		strValue := fmt.Sprintf("%v", value)
-		if strValue != "" {
-			config[strKey] = strValue
-		}
-	}
+	config := expandHookConfig(d)
 
-	hook := giteaapi.CreateHookOption{
-		Type: hookType,
-		BranchFilter: branchFilter,
-		Events: events,
-		Config: config,
-		Active: active,
+	hook := createHookOption{
+		CreateHookOption: giteaapi.CreateHookOption{
+			Type: hookType,
+			BranchFilter: branchFilter,
+			Events: events,
+			Config: config,
+			Active: active,
+		},
+		AuthorizationHeader: d.Get("authorization_header").(string),
 	}
 
 	return hook, nil
 }
 
-func resourceGiteaRepositoryHookUpdateObject(d *schema.ResourceData) (giteaapi.EditHookOption, error) {
+func resourceGiteaRepositoryHookUpdateObject(d *schema.ResourceData) (editHookOption, error) {
 	branchFilter := d.Get("branch_filter").(string)
 	active := d.Get("active").(bool)
 	var events []string
@@ -209,21 +230,16 @@ func resourceGiteaRepositoryHookUpdateObject(d *schema.ResourceData) (giteaapi.E
 		events = append(events, v.(string))
 	}
 
-	config := map[string]string{}
-	configList := d.Get("config").([]interface{})
-	for key, value := range configList[0].(map[string]interface{}) {
-		strKey := fmt.Sprintf("%v", key)
-		strValue := fmt.Sprintf("%v", value)
-		if strValue != "" {
-			config[strKey] = strValue
-		}
-	}
+	config := expandHookConfig(d)
 
-	hook := giteaapi.EditHookOption{
-		BranchFilter: branchFilter,
-		Events: events,
-		Config: config,
-		Active: &active,
+	hook := editHookOption{
+		EditHookOption: giteaapi.EditHookOption{
+			BranchFilter: branchFilter,
+			Events: events,
+			Config: config,
+			Active: &active,
+		},
+		AuthorizationHeader: d.Get("authorization_header").(string),
 	}
 
 	return hook, nil
@@ -236,7 +252,7 @@ func resourceGiteaRepositoryHookImportState(d *schema.ResourceData, meta interfa
 		return nil, fmt.Errorf("Invalid import id %q. Expecting {owner}/{repo}/{id}", d.Id())
 	}
 
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	owner := parts[0]
 	repo := parts[1]
 	hookId, err := strconv.ParseInt(parts[2], 10, 64)
@@ -247,7 +263,7 @@ func resourceGiteaRepositoryHookImportState(d *schema.ResourceData, meta interfa
 	hook, err := client.GetRepoHook(owner, repo, hookId)
 
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve repository hook %s %s %d", owner, hook, hookId)
+		return nil, fmt.Errorf("unable to retrieve repository hook %s %s %d", owner, repo, hookId)
 	}
 
 	d.Set("owner", owner)
@@ -1,10 +1,11 @@
 package gitea
 
+import "testing"
+
 // import (
 // 	"fmt"
 // 	"testing"
 
-// 	giteaapi "code.gitea.io/sdk/gitea"
 // 	"github.com/hashicorp/terraform/helper/resource"
 // 	"github.com/hashicorp/terraform/terraform"
 // )
@@ -34,7 +35,7 @@ package gitea
 
 // func testCheckGiteaRepositoryExists(n string, t *testing.T) resource.TestCheckFunc {
 // 	return func(s *terraform.State) error {
-// 		client := testAccProvider.Meta().(*giteaapi.Client)
+// 		client := testAccProvider.Meta().(*Client)
 
 // 		rs, ok := s.RootModule().Resources[n]
 // 		if !ok {
@@ -61,7 +62,7 @@ package gitea
 // }
 
 // func testAccGiteaRepositoryDestroy(s *terraform.State) error {
-// 	client := testAccProvider.Meta().(*giteaapi.Client)
+// 	client := testAccProvider.Meta().(*Client)
 
 // 	for _, rs := range s.RootModule().Resources {
 // 		if rs.Type != "gitea_repository" {
@@ -79,3 +80,34 @@ package gitea
 
 // 	return nil
 // }
+
+func TestSuppressEquivalentDuration(t *testing.T) {
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"8h0m0s", "8h", true},
+		{"1h30m0s", "90m", true},
+		{"8h0m0s", "10h", false},
+		{"", "8h", false},
+		{"8h0m0s", "", false},
+	}
+	for _, c := range cases {
+		if got := suppressEquivalentDuration("mirror_interval", c.old, c.new, nil); got != c.expected {
+			t.Errorf("%q to %q: expected %t, got %t", c.old, c.new, c.expected, got)
+		}
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	for _, value := range []string{"8h", "1h30m", "10m0s"} {
+		if _, errs := validateDuration(value, "mirror_interval"); len(errs) != 0 {
+			t.Errorf("%q: unexpected errors %v", value, errs)
+		}
+	}
+	for _, value := range []string{"", "8", "eight hours"} {
+		if _, errs := validateDuration(value, "mirror_interval"); len(errs) == 0 {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// systemHookScope is the API path prefix of the admin hooks
+const systemHookScope = "/admin"
+
+func resourceGiteaSystemHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaSystemHookCreate,
+		Read:   resourceGiteaSystemHookRead,
+		Update: resourceGiteaSystemHookUpdate,
+		Delete: resourceGiteaSystemHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaSystemHookImportState,
+		},
+		CustomizeDiff: validateHookConfiguration,
+		Schema: map[string]*schema.Schema{
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"type": hookTypeSchema(),
+			"branch_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"config":               hookConfigurationSchema(),
+			"authorization_header": hookAuthorizationHeaderSchema(),
+			"slack":                hookSlackConfigurationSchema(),
+			"telegram":             hookTelegramConfigurationSchema(),
+			"matrix":               hookMatrixConfigurationSchema(),
+			"packagist":            hookPackagistConfigurationSchema(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"events": hookEventsSchema(),
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceGiteaSystemHookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	object, err := resourceGiteaRepositoryHookCreateObject(d)
+	if err != nil {
+		return err
+	}
+	isSystem := !d.Get("default").(bool)
+	object.IsSystemWebhook = &isSystem
+
+	log.Printf("[DEBUG] create system hook: %v", object)
+
+	hook, err := client.createHook(systemHookScope, object)
+	if err != nil {
+		return fmt.Errorf("unable to create system hook: %v", err)
+	}
+	log.Printf("[DEBUG] system hook created %v", hook)
+	d.SetId(strconv.FormatInt(hook.ID, 10))
+
+	return resourceGiteaSystemHookRead(d, meta)
+}
+
+func resourceGiteaSystemHookRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	hookId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	log.Printf("[DEBUG] read system hook %d", hookId)
+
+	hook, err := client.getHook(systemHookScope, hookId)
+	if isNotFoundErr(err) {
+		log.Printf("[WARN] system hook %d not found, removing from state", hookId)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] system hook find %v", hook)
+
+	isDefault, known, err := resourceGiteaSystemHookIsDefault(client, hookId)
+	if err != nil {
+		return err
+	}
+	if known {
+		d.Set("default", isDefault)
+	}
+	return resourceGiteaRepositoryHookSetToState(d, hook)
+}
+
+func resourceGiteaSystemHookUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	hookId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	object, err := resourceGiteaRepositoryHookUpdateObject(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] edit system hook: %d %v", hookId, object)
+	err = client.editHook(systemHookScope, hookId, object)
+	if err != nil {
+		return fmt.Errorf("unable to edit system hook %d: %v", hookId, err)
+	}
+
+	return resourceGiteaSystemHookRead(d, meta)
+}
+
+func resourceGiteaSystemHookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	log.Printf("[DEBUG] delete system hook: %d", id)
+	return client.deleteHook(systemHookScope, id)
+}
+
+func resourceGiteaSystemHookImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client)
+	hookId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return nil, unconvertibleIdErr(d.Id(), err)
+	}
+
+	hook, err := client.getHook(systemHookScope, hookId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve system hook %d: %v", hookId, err)
+	}
+
+	isDefault, known, err := resourceGiteaSystemHookIsDefault(client, hookId)
+	if err != nil {
+		return nil, err
+	}
+	if !known {
+		return nil, fmt.Errorf("unable to tell whether admin hook %d is a system or a default hook, this needs Gitea 1.22 or later", hookId)
+	}
+	d.Set("default", isDefault)
+	d.SetId(fmt.Sprintf("%d", hookId))
+	err = resourceGiteaRepositoryHookSetToState(d, hook)
+	return []*schema.ResourceData{d}, err
+}
+
+// resourceGiteaSystemHookIsDefault finds out whether an admin hook is a
+// default hook by looking it up in the system and default hook lists. known is
+// false when the server does not filter the lists by type, as Gitea only does
+// since 1.22.
+func resourceGiteaSystemHookIsDefault(client *Client, id int64) (isDefault bool, known bool, err error) {
+	contains := func(hookType string) (bool, error) {
+		hooks, err := client.listHooks(systemHookScope, url.Values{"type": []string{hookType}})
+		if err != nil {
+			return false, fmt.Errorf("unable to list %s hooks: %v", hookType, err)
+		}
+		for _, hook := range hooks {
+			if hook.ID == id {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	inSystem, err := contains("system")
+	if err != nil {
+		return false, false, err
+	}
+	inDefault, err := contains("default")
+	if err != nil {
+		return false, false, err
+	}
+	if inSystem == inDefault {
+		return false, false, nil
+	}
+	return inDefault, true, nil
+}
@@ -3,6 +3,8 @@ package gitea
 import (
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 
 	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +19,7 @@ func resourceGiteaUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeAvatarDiff,
 
 		Schema: map[string]*schema.Schema{
 			"login": {
@@ -45,6 +48,19 @@ func resourceGiteaUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// adopting a user keeps its password unless this is set
+			"adopt_existing_reset_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"avatar":      avatarSchema(),
+			"avatar_hash": avatarHashSchema(),
 			"is_admin": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -71,7 +87,7 @@ func resourceGiteaUserSetToState(d *schema.ResourceData, user *giteaapi.User) er
 }
 
 func resourceGiteaUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	options := giteaapi.CreateUserOption{
 		Email:      d.Get("email").(string),
 		FullName:   d.Get("fullname").(string),
@@ -81,6 +97,21 @@ func resourceGiteaUserCreate(d *schema.ResourceData, meta interface{}) error {
 		Username:   d.Get("username").(string),
 	}
 
+	if d.Get("adopt_existing").(bool) {
+		user, err := client.GetUserInfo(options.Username)
+		if err == nil && user.ID != 0 {
+			log.Printf("[DEBUG] adopt existing user %q", options.Username)
+			d.SetId(fmt.Sprintf("%d", user.ID))
+			if err := resourceGiteaUserApplyAvatar(d, client); err != nil {
+				return err
+			}
+			return resourceGiteaUserUpdate(d, meta)
+		}
+		if err != nil && !isNotFoundErr(err) {
+			return fmt.Errorf("unable to retrieve user %s: %v", options.Username, err)
+		}
+	}
+
 	log.Printf("[DEBUG] create user %q", options.Username)
 
 	user, err := client.AdminCreateUser(options)
@@ -89,6 +120,9 @@ func resourceGiteaUserCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] user created: %v", user)
 	d.SetId(fmt.Sprintf("%d", user.ID))
+	if err := resourceGiteaUserApplyAvatar(d, client); err != nil {
+		return err
+	}
 	if d.Get("is_admin").(bool) {
 		return resourceGiteaUserUpdate(d, meta)
 	}
@@ -96,7 +130,7 @@ func resourceGiteaUserCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGiteaUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	username := d.Get("username").(string)
 	log.Printf("[DEBUG] read user %q %s", d.Id(), username)
 	user, err := client.GetUserInfo(username)
@@ -108,7 +142,7 @@ func resourceGiteaUserRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGiteaUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	log.Printf("[DEBUG] update user %s", d.Id())
 	isAdmin := d.Get("is_admin").(bool)
 	username := d.Get("username").(string)
@@ -117,19 +151,52 @@ func resourceGiteaUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		Email:     d.Get("email").(string),
 		FullName:  d.Get("fullname").(string),
 		LoginName: d.Get("login").(string),
-		Password:  d.Get("password").(string),
+	}
+	// Gitea leaves the password alone when none is sent. A new resource either
+	// got it on creation or adopts a live user, whose password is only reset
+	// when asked to.
+	if d.IsNewResource() {
+		if d.Get("adopt_existing").(bool) && d.Get("adopt_existing_reset_password").(bool) {
+			edit.Password = d.Get("password").(string)
+		}
+	} else if d.HasChange("password") {
+		edit.Password = d.Get("password").(string)
 	}
 
 	err := client.AdminEditUser(username, edit)
 	if err != nil {
 		return fmt.Errorf("unable to edit user: %s", username)
 	}
+	// new users got their avatar in Create already
+	if !d.IsNewResource() {
+		if err := resourceGiteaUserApplyAvatar(d, client); err != nil {
+			return err
+		}
+	}
 
 	return resourceGiteaUserRead(d, meta)
 }
 
+// resourceGiteaUserApplyAvatar sets the avatar of the user. Gitea only lets
+// users change their own avatar, so admins act on behalf of other users.
+func resourceGiteaUserApplyAvatar(d *schema.ResourceData, client *Client) error {
+	if !d.HasChange("avatar") && !d.HasChange("avatar_hash") {
+		return nil
+	}
+	username := d.Get("username").(string)
+	me, err := client.GetMyUserInfo()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the user of the token: %v", err)
+	}
+	path := "/user/avatar"
+	if !strings.EqualFold(me.UserName, username) {
+		path += "?sudo=" + url.QueryEscape(username)
+	}
+	return applyAvatar(d, client, path)
+}
+
 func resourceGiteaUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*giteaapi.Client)
+	client := meta.(*Client)
 	log.Printf("[DEBUG] delete user %s", d.Id())
 	return client.AdminDeleteUser(d.Get("username").(string))
 }
@@ -0,0 +1,83 @@
+package gitea
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceGiteaUserBlock blocks a user on behalf of the owner of the token
+func resourceGiteaUserBlock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGiteaUserBlockCreate,
+		Read:   resourceGiteaUserBlockRead,
+		Delete: resourceGiteaUserBlockDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGiteaUserBlockImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Gitea does not return the note, it is kept as configured
+			"note": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGiteaUserBlockCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	username := d.Get("username").(string)
+
+	log.Printf("[DEBUG] block user %s", username)
+	if err := client.blockUser(userBlockScope, username, d.Get("note").(string)); err != nil {
+		return fmt.Errorf("unable to block user %s: %v", username, err)
+	}
+
+	d.SetId(username)
+	return resourceGiteaUserBlockRead(d, meta)
+}
+
+func resourceGiteaUserBlockRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	username := d.Get("username").(string)
+	log.Printf("[DEBUG] read block of user %s", username)
+
+	blocked, err := client.findBlock(userBlockScope, username)
+	if err != nil {
+		return fmt.Errorf("unable to list blocked users: %v", err)
+	}
+	if blocked == nil {
+		log.Printf("[WARN] user %s is not blocked, removing from state", username)
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceGiteaUserBlockDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	username := d.Get("username").(string)
+	log.Printf("[DEBUG] unblock user %s", username)
+	return client.unblockUser(userBlockScope, username)
+}
+
+func resourceGiteaUserBlockImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client)
+	blocked, err := client.findBlock(userBlockScope, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list blocked users: %v", err)
+	}
+	if blocked == nil {
+		return nil, fmt.Errorf("user %s is not blocked", d.Id())
+	}
+
+	d.Set("username", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
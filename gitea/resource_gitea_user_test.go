@@ -1,11 +1,14 @@
 package gitea
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
-	giteaapi "code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -37,7 +40,7 @@ func TestAccGiteaUser_basic(t *testing.T) {
 
 func testCheckGiteaUserExists(n string, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*giteaapi.Client)
+		client := testAccProvider.Meta().(*Client)
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -60,7 +63,7 @@ func testCheckGiteaUserExists(n string, t *testing.T) resource.TestCheckFunc {
 }
 
 func testAccGiteaUserDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*giteaapi.Client)
+	client := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitea_user" {
@@ -77,3 +80,89 @@ func testAccGiteaUserDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestResourceGiteaUserCreateUploadsAvatarOnce(t *testing.T) {
+	uploads := 0
+	client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/admin/users":
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"id": 7, "login": "johndoe"})
+		case r.Method == "PATCH" && r.URL.Path == "/api/v1/admin/users/johndoe":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": 7, "login": "johndoe"})
+		case r.Method == "GET" && r.URL.Path == "/api/v1/user":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": 1, "login": "admin"})
+		case r.Method == "POST" && r.URL.Path == "/api/v1/user/avatar":
+			uploads++
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/users/johndoe":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": 7, "login": "johndoe"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer done()
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaUser().Schema, map[string]interface{}{
+		"login":    "johndoe",
+		"username": "johndoe",
+		"password": "pass",
+		"fullname": "John Doe",
+		"email":    "john.doe@gitea.io",
+		"is_admin": true,
+		"avatar":   base64.StdEncoding.EncodeToString([]byte("png")),
+	})
+	d.MarkNewResource()
+	if err := resourceGiteaUserCreate(d, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uploads != 1 {
+		t.Errorf("expected the avatar to be uploaded once, got %d uploads", uploads)
+	}
+}
+
+func TestResourceGiteaUserAdoptKeepsPassword(t *testing.T) {
+	cases := []struct {
+		name     string
+		reset    bool
+		password string
+	}{
+		{name: "kept", reset: false, password: ""},
+		{name: "reset", reset: true, password: "pass"},
+	}
+
+	for _, c := range cases {
+		client, done := testAPIClient(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "GET" && r.URL.Path == "/api/v1/users/johndoe":
+				writeJSON(w, http.StatusOK, map[string]interface{}{"id": 7, "login": "johndoe"})
+			case r.Method == "PATCH" && r.URL.Path == "/api/v1/admin/users/johndoe":
+				edit := map[string]interface{}{}
+				json.NewDecoder(r.Body).Decode(&edit)
+				if edit["password"] != c.password {
+					t.Errorf("%s: expected password %q, got %q", c.name, c.password, edit["password"])
+				}
+				writeJSON(w, http.StatusOK, map[string]interface{}{"id": 7, "login": "johndoe"})
+			default:
+				t.Errorf("%s: unexpected request %s %s", c.name, r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		d := schema.TestResourceDataRaw(t, resourceGiteaUser().Schema, map[string]interface{}{
+			"login":                         "johndoe",
+			"username":                      "johndoe",
+			"password":                      "pass",
+			"fullname":                      "John Doe",
+			"email":                         "john.doe@gitea.io",
+			"adopt_existing":                true,
+			"adopt_existing_reset_password": c.reset,
+		})
+		d.MarkNewResource()
+		err := resourceGiteaUserCreate(d, client)
+		done()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package gitea
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// avatarSchema is an avatar image given either as the path of a local file or
+// as its base64 encoded content. Base64 content is stored as its hash so the
+// image does not end up in the state.
+func avatarSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		StateFunc: avatarStateFunc,
+	}
+}
+
+// avatarHashPrefix marks avatar values stored as the hash of their content
+const avatarHashPrefix = "sha256:"
+
+func avatarStateFunc(v interface{}) string {
+	value := v.(string)
+	if _, err := os.Stat(value); value == "" || err == nil {
+		return value
+	}
+	hash, err := avatarHash(value)
+	if err != nil {
+		// left for customizeAvatarDiff to report
+		return value
+	}
+	return avatarHashPrefix + hash
+}
+
+// avatarHashSchema holds the hash of the avatar content so that changes to
+// the file behind an unchanged path are detected
+func avatarHashSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// readAvatar returns the base64 encoded content of an avatar attribute
+func readAvatar(value string) (string, error) {
+	if _, err := os.Stat(value); err == nil {
+		data, err := ioutil.ReadFile(value)
+		if err != nil {
+			return "", fmt.Errorf("unable to read avatar %s: %v", value, err)
+		}
+		return base64.StdEncoding.EncodeToString(data), nil
+	}
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		return "", fmt.Errorf("avatar is neither an existing file nor base64 content")
+	}
+	return value, nil
+}
+
+// avatarHash returns the hash stored in avatar_hash for an avatar attribute,
+// empty when there is no avatar
+func avatarHash(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	content, err := readAvatar(value)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(content))), nil
+}
+
+// customizeAvatarDiff plans an avatar upload when the content behind the
+// avatar attribute changed.
+func customizeAvatarDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("avatar") {
+		return d.SetNewComputed("avatar_hash")
+	}
+	value := d.Get("avatar").(string)
+	if strings.HasPrefix(value, avatarHashPrefix) {
+		// the value from the state, whose hash is avatar_hash already
+		return nil
+	}
+	hash, err := avatarHash(value)
+	if err != nil {
+		return err
+	}
+	if hash != d.Get("avatar_hash").(string) {
+		return d.SetNew("avatar_hash", hash)
+	}
+	return nil
+}
+
+// applyAvatar uploads the avatar to the avatar endpoint at path, or deletes
+// it when the attribute was removed.
+func applyAvatar(d *schema.ResourceData, client *Client, path string) error {
+	if !d.HasChange("avatar") && !d.HasChange("avatar_hash") {
+		return nil
+	}
+	value := d.Get("avatar").(string)
+	if value == "" {
+		if d.IsNewResource() {
+			return nil
+		}
+		log.Printf("[DEBUG] delete avatar %s", path)
+		if err := client.apiRequest("DELETE", path, nil, nil); err != nil {
+			return fmt.Errorf("unable to delete avatar: %v", err)
+		}
+		d.Set("avatar_hash", "")
+		return nil
+	}
+
+	content, err := readAvatar(value)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] upload avatar %s", path)
+	if err := client.apiRequest("POST", path, map[string]string{"image": content}, nil); err != nil {
+		return fmt.Errorf("unable to upload avatar: %v", err)
+	}
+	hash, _ := avatarHash(value)
+	d.Set("avatar_hash", hash)
+	return nil
+}
@@ -0,0 +1,71 @@
+package gitea
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAvatarStateFunc(t *testing.T) {
+	file, err := ioutil.TempFile("", "avatar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.Write([]byte("png"))
+	file.Close()
+
+	content := base64.StdEncoding.EncodeToString([]byte("png"))
+	hash, err := avatarHash(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "empty", value: "", expected: ""},
+		{name: "file", value: file.Name(), expected: file.Name()},
+		{name: "base64", value: content, expected: avatarHashPrefix + hash},
+	}
+	for _, c := range cases {
+		if got := avatarStateFunc(c.value); got != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.name, c.expected, got)
+		}
+	}
+	if strings.Contains(avatarStateFunc(content), content) {
+		t.Error("base64 content must not be kept in the state")
+	}
+}
+
+func TestAvatarHash(t *testing.T) {
+	file, err := ioutil.TempFile("", "avatar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.Write([]byte("png"))
+	file.Close()
+
+	fromFile, err := avatarHash(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromContent, err := avatarHash(base64.StdEncoding.EncodeToString([]byte("png")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromFile == "" || fromFile != fromContent {
+		t.Errorf("expected the same hash for a file and its content, got %q and %q", fromFile, fromContent)
+	}
+	if hash, err := avatarHash(""); err != nil || hash != "" {
+		t.Errorf("expected no hash without avatar, got %q, %v", hash, err)
+	}
+	if _, err := avatarHash("not an image!"); err == nil {
+		t.Error("expected an error for content that is neither a file nor base64")
+	}
+}
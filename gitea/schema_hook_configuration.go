@@ -1,32 +1,304 @@
 package gitea
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+var hookTypes = []string{
+	"gitea", "gogs", "slack", "discord", "dingtalk", "telegram",
+	"msteams", "feishu", "matrix", "wechatwork", "packagist",
+}
+
+var hookEvents = []string{
+	"create", "delete", "fork", "push", "issues", "issue_assign",
+	"issue_label", "issue_milestone", "issue_comment", "pull_request",
+	"pull_request_assign", "pull_request_label", "pull_request_milestone",
+	"pull_request_comment", "pull_request_review", "pull_request_review_approved",
+	"pull_request_review_rejected", "pull_request_review_comment",
+	"pull_request_sync", "pull_request_review_request", "wiki", "repository",
+	"release", "package", "status", "workflow_job", "workflow_run",
+}
+
+// hookTypedBlocks lists the hook types having their own configuration block,
+// the block is named after the type. Gitea only keeps the options of slack,
+// the other blocks are the parts of the delivery url.
+var hookTypedBlocks = []string{"slack", "telegram", "matrix", "packagist"}
+
+// hookDerivedURLTypes are the hook types whose delivery url is built from
+// their configuration block rather than given in config.
+var hookDerivedURLTypes = []string{"telegram", "matrix", "packagist"}
+
+func hookTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "gitea",
+		ValidateFunc: validation.StringInSlice(hookTypes, false),
+	}
+}
+
+func hookEventsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(hookEvents, false),
+		},
+		Set: schema.HashString,
+	}
+}
+
 func hookConfigurationSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		MaxItems: 1,
-		Required: true,
+		Optional: true,
+		Computed: true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				// the url holds the token of the telegram and packagist hooks
 				"url": {
-					Type:     schema.TypeString,
-					Required: true,
+					Type:      schema.TypeString,
+					Optional:  true,
+					Computed:  true,
+					Sensitive: true,
 				},
 				"content_type": {
-					Type:     schema.TypeString,
-					Optional: true,
-					Default: "json",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "json",
+					ValidateFunc: validation.StringInSlice([]string{"json", "form"}, false),
 				},
 				"secret": {
 					Type:      schema.TypeString,
 					Optional:  true,
 					Sensitive: true,
-					ForceNew:  true,
 				},
 			},
 		},
 	}
-}
\ No newline at end of file
+}
+
+// hookAuthorizationHeaderSchema is the Authorization header Gitea sends with
+// every delivery, e.g. "Bearer <token>". It is write-only: Gitea never
+// returns it, so it is kept as configured and changes made outside of
+// Terraform are not detected.
+func hookAuthorizationHeaderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	}
+}
+
+func hookSlackConfigurationSchema() *schema.Schema {
+	return hookTypedConfigurationSchema(map[string]*schema.Schema{
+		"channel": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"icon_url": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"color": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	})
+}
+
+func hookTelegramConfigurationSchema() *schema.Schema {
+	return hookTypedConfigurationSchema(map[string]*schema.Schema{
+		"bot_token": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"chat_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"thread_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	})
+}
+
+func hookMatrixConfigurationSchema() *schema.Schema {
+	return hookTypedConfigurationSchema(map[string]*schema.Schema{
+		"homeserver_url": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"room_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	})
+}
+
+func hookPackagistConfigurationSchema() *schema.Schema {
+	return hookTypedConfigurationSchema(map[string]*schema.Schema{
+		"username": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"api_token": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	})
+}
+
+func hookTypedConfigurationSchema(s map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+// hookTypedConfiguration returns the configuration block matching the hook
+// type, or nil when there is none.
+func hookTypedConfiguration(d *schema.ResourceData, hookType string) map[string]interface{} {
+	if !stringInSlice(hookType, hookTypedBlocks) {
+		return nil
+	}
+	blocks := d.Get(hookType).([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	return blocks[0].(map[string]interface{})
+}
+
+// expandHookConfig builds the configuration sent to Gitea from the config
+// block and the block of the hook type.
+func expandHookConfig(d *schema.ResourceData) map[string]string {
+	config := map[string]string{}
+	configList := d.Get("config").([]interface{})
+	if len(configList) > 0 && configList[0] != nil {
+		for key, value := range configList[0].(map[string]interface{}) {
+			strValue := fmt.Sprintf("%v", value)
+			if strValue != "" {
+				config[key] = strValue
+			}
+		}
+	}
+	if config["content_type"] == "" {
+		config["content_type"] = "json"
+	}
+
+	hookType := d.Get("type").(string)
+	block := hookTypedConfiguration(d, hookType)
+	if block == nil {
+		return config
+	}
+	for key, value := range block {
+		strValue := fmt.Sprintf("%v", value)
+		if strValue != "" {
+			config[key] = strValue
+		}
+	}
+
+	switch hookType {
+	case "telegram":
+		query := url.Values{}
+		query.Set("chat_id", config["chat_id"])
+		if config["thread_id"] != "" {
+			query.Set("message_thread_id", config["thread_id"])
+		}
+		config["url"] = fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage?%s", url.PathEscape(config["bot_token"]), query.Encode())
+	case "matrix":
+		config["url"] = fmt.Sprintf("%s/_matrix/client/r0/rooms/%s/send/m.room.message",
+			strings.TrimSuffix(config["homeserver_url"], "/"), url.PathEscape(config["room_id"]))
+	case "packagist":
+		query := url.Values{}
+		query.Set("username", config["username"])
+		query.Set("apiToken", config["api_token"])
+		config["url"] = "https://packagist.org/api/update-package?" + query.Encode()
+	}
+	return config
+}
+
+// flattenHookConfig splits the configuration returned by Gitea between the
+// config block and the block of the hook type. Gitea only returns the url,
+// the content type and the slack options. The other blocks are kept from
+// what is currently in ResourceData, drifts show up as a change of the url
+// built from them.
+func flattenHookConfig(d *schema.ResourceData, hook map[string]string) {
+	config := map[string]interface{}{}
+	for _, key := range []string{"url", "content_type", "secret"} {
+		if value, ok := hook[key]; ok {
+			config[key] = value
+		}
+	}
+	d.Set("config", []interface{}{config})
+
+	hookType := d.Get("type").(string)
+	block := hookTypedConfiguration(d, hookType)
+	if block == nil {
+		return
+	}
+	for key := range block {
+		if value, ok := hook[key]; ok {
+			block[key] = value
+		}
+	}
+	d.Set(hookType, []interface{}{block})
+}
+
+// validateHookConfiguration checks at plan time that only the configuration
+// block of the chosen hook type is set and that a delivery url is known.
+func validateHookConfiguration(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	hookType := d.Get("type").(string)
+	for _, block := range hookTypedBlocks {
+		if block != hookType && len(d.Get(block).([]interface{})) > 0 {
+			return fmt.Errorf("%s block can only be set when type is %q, got %q", block, block, hookType)
+		}
+	}
+	if hookType == "slack" && len(d.Get("slack").([]interface{})) == 0 {
+		return fmt.Errorf("slack block is required when type is \"slack\"")
+	}
+	if stringInSlice(hookType, hookDerivedURLTypes) {
+		if len(d.Get(hookType).([]interface{})) == 0 {
+			return fmt.Errorf("%s block is required when type is %q", hookType, hookType)
+		}
+		return nil
+	}
+	if !d.NewValueKnown("config.0.url") {
+		return nil
+	}
+	configList := d.Get("config").([]interface{})
+	if len(configList) == 0 || configList[0] == nil || configList[0].(map[string]interface{})["url"] == "" {
+		return fmt.Errorf("config url is required when type is %q", hookType)
+	}
+	return nil
+}
+
+func stringInSlice(value string, list []string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package gitea
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestExpandHookConfig(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected map[string]string
+	}{
+		{
+			name: "gitea",
+			raw: map[string]interface{}{
+				"events": []interface{}{"push"},
+				"config": []interface{}{map[string]interface{}{
+					"url":    "https://ci.example.com/hook",
+					"secret": "s3cr3t",
+				}},
+			},
+			expected: map[string]string{
+				"url":          "https://ci.example.com/hook",
+				"content_type": "json",
+				"secret":       "s3cr3t",
+			},
+		},
+		{
+			name: "slack",
+			raw: map[string]interface{}{
+				"type":   "slack",
+				"events": []interface{}{"push"},
+				"config": []interface{}{map[string]interface{}{
+					"url": "https://hooks.slack.com/services/T/B/X",
+				}},
+				"slack": []interface{}{map[string]interface{}{
+					"channel":  "#builds",
+					"username": "gitea",
+				}},
+			},
+			expected: map[string]string{
+				"url":          "https://hooks.slack.com/services/T/B/X",
+				"content_type": "json",
+				"channel":      "#builds",
+				"username":     "gitea",
+			},
+		},
+		{
+			name: "matrix",
+			raw: map[string]interface{}{
+				"type":   "matrix",
+				"events": []interface{}{"push"},
+				"matrix": []interface{}{map[string]interface{}{
+					"homeserver_url": "https://matrix.example.com/",
+					"room_id":        "!abc:example.com",
+				}},
+			},
+			expected: map[string]string{
+				"url":            "https://matrix.example.com/_matrix/client/r0/rooms/%21abc:example.com/send/m.room.message",
+				"content_type":   "json",
+				"homeserver_url": "https://matrix.example.com/",
+				"room_id":        "!abc:example.com",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceGiteaRepositoryHook().Schema, c.raw)
+		config := expandHookConfig(d)
+		if len(config) != len(c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, config)
+			continue
+		}
+		for key, value := range c.expected {
+			if config[key] != value {
+				t.Errorf("%s: expected %s to be %q, got %q", c.name, key, value, config[key])
+			}
+		}
+	}
+}
+
+func TestHookConfigurationURLIsSensitive(t *testing.T) {
+	url := hookConfigurationSchema().Elem.(*schema.Resource).Schema["url"]
+	if !url.Sensitive {
+		t.Error("config url holds the telegram and packagist tokens, it must be sensitive")
+	}
+}
+
+func TestFlattenHookConfigKeepsTypedBlock(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepositoryHook().Schema, map[string]interface{}{
+		"type":   "slack",
+		"events": []interface{}{"push"},
+		"slack": []interface{}{map[string]interface{}{
+			"channel": "#builds",
+		}},
+	})
+	flattenHookConfig(d, map[string]string{
+		"url":          "https://hooks.slack.com/services/T/B/X",
+		"content_type": "json",
+		"channel":      "#deploys",
+	})
+	if got := d.Get("config.0.url").(string); got != "https://hooks.slack.com/services/T/B/X" {
+		t.Errorf("expected the url from Gitea, got %q", got)
+	}
+	if got := d.Get("slack.0.channel").(string); got != "#deploys" {
+		t.Errorf("expected the channel from Gitea, got %q", got)
+	}
+}